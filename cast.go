@@ -0,0 +1,138 @@
+package sugar
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// CastError is returned by Cast and its variants when a value does not have
+// the expected type, and is the panic value of MustCast.
+type CastError struct {
+	// Expected is the type the value was asserted to.
+	Expected reflect.Type
+
+	// Actual is the dynamic type of the value, or nil if the value was nil.
+	Actual reflect.Type
+
+	// Index is the position of the mismatching element for CastSlice, and -1
+	// otherwise.
+	Index int
+
+	// Key is the key of the mismatching entry for CastMap, and nil otherwise.
+	Key any
+}
+
+// Error describes the mismatch, for example "sugar: expected int, got string"
+// or "sugar: element 3: expected int, got string".
+func (e *CastError) Error() string {
+	actual := "nil"
+	if e.Actual != nil {
+		actual = e.Actual.String()
+	}
+	switch {
+	case e.Index >= 0:
+		return fmt.Sprintf("sugar: element %d: expected %s, got %s", e.Index, e.Expected, actual)
+	case e.Key != nil:
+		return fmt.Sprintf("sugar: key %v: expected %s, got %s", e.Key, e.Expected, actual)
+	default:
+		return fmt.Sprintf("sugar: expected %s, got %s", e.Expected, actual)
+	}
+}
+
+func newCastError[T any](v any) *CastError {
+	return &CastError{
+		Expected: reflect.TypeOf((*T)(nil)).Elem(),
+		Actual:   reflect.TypeOf(v),
+		Index:    -1,
+	}
+}
+
+// Cast asserts that v holds a value of type T. It is the error-returning form
+// of the type assertion v.(T): on mismatch it returns Zero[T]() and a
+// *CastError describing both types, without the cost of panicking and
+// recovering through Try.
+//
+// Example usage:
+//
+//	port, err := Cast[int](settings["port"])
+//	if err != nil {
+//	    return err // sugar: expected int, got string
+//	}
+func Cast[T any](v any) (T, error) {
+	t, ok := v.(T)
+	if !ok {
+		return Zero[T](), newCastError[T](v)
+	}
+	return t, nil
+}
+
+// MustCast is like Cast but panics with the *CastError on mismatch, so that
+// Try and errors.As can still inspect it.
+//
+//	name := MustCast[string](claims["sub"])
+func MustCast[T any](v any) T {
+	return Must(Cast[T](v))
+}
+
+// CastOr is like Cast but returns def on mismatch.
+//
+//	limit := CastOr(params["limit"], 100)
+func CastOr[T any](v any, def T) T {
+	t, ok := v.(T)
+	if !ok {
+		return def
+	}
+	return t
+}
+
+// CastSlice casts every element of vs to T. On the first mismatch it returns
+// nil and a *CastError whose Index is the position of that element.
+//
+// Example usage:
+//
+//	var raw []any
+//	Must0(json.Unmarshal(data, &raw))
+//	names, err := CastSlice[string](raw)
+//	// sugar: element 2: expected string, got float64
+func CastSlice[T any](vs []any) ([]T, error) {
+	if vs == nil {
+		return nil, nil
+	}
+	out := make([]T, len(vs))
+	for i, v := range vs {
+		t, ok := v.(T)
+		if !ok {
+			err := newCastError[T](v)
+			err.Index = i
+			return nil, err
+		}
+		out[i] = t
+	}
+	return out, nil
+}
+
+// CastMap casts every value of m to T. On a mismatch it returns nil and a
+// *CastError whose Key is the key of that entry. If several entries mismatch,
+// which one is reported is unspecified.
+//
+// Example usage:
+//
+//	var raw map[string]any
+//	Must0(json.Unmarshal(data, &raw))
+//	labels, err := CastMap[string, string](raw)
+func CastMap[K comparable, T any](m map[K]any) (map[K]T, error) {
+	if m == nil {
+		return nil, nil
+	}
+	out := make(map[K]T, len(m))
+	for k, v := range m {
+		t, ok := v.(T)
+		if !ok {
+			err := newCastError[T](v)
+			err.Key = k
+			return nil, err
+		}
+		out[k] = t
+	}
+	return out, nil
+}
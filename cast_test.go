@@ -0,0 +1,176 @@
+package sugar
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+)
+
+func TestCast(t *testing.T) {
+	// Test Cast with matching and mismatching types
+	t.Run("match", func(t *testing.T) {
+		if v, err := Cast[int](any(42)); err != nil || v != 42 {
+			t.Errorf("Expected (42, nil), got (%d, %v)", v, err)
+		}
+	})
+
+	t.Run("interface", func(t *testing.T) {
+		v, err := Cast[error](any(io.EOF))
+		if err != nil || v != io.EOF {
+			t.Errorf("Expected (EOF, nil), got (%v, %v)", v, err)
+		}
+
+		_, err = Cast[fmt.Stringer](any(42))
+		expected := "sugar: expected fmt.Stringer, got int"
+		if err == nil || err.Error() != expected {
+			t.Errorf("Expected error message %q, got %v", expected, err)
+		}
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		v, err := Cast[int](any("42"))
+		if v != 0 {
+			t.Errorf("Expected zero value, got %d", v)
+		}
+
+		var ce *CastError
+		if !errors.As(err, &ce) {
+			t.Fatalf("Expected *CastError, got %v", err)
+		}
+		expected := "sugar: expected int, got string"
+		if err.Error() != expected {
+			t.Errorf("Expected error message %q, got %q", expected, err.Error())
+		}
+		if ce.Index != -1 || ce.Key != nil {
+			t.Errorf("Expected no index or key, got %d and %v", ce.Index, ce.Key)
+		}
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		_, err := Cast[*int](nil)
+		expected := "sugar: expected *int, got nil"
+		if err == nil || err.Error() != expected {
+			t.Errorf("Expected error message %q, got %v", expected, err)
+		}
+	})
+}
+
+func TestMustCast(t *testing.T) {
+	// Test that MustCast returns the value or panics with *CastError
+	if v := MustCast[string](any("hello")); v != "hello" {
+		t.Errorf("Expected hello, got %q", v)
+	}
+
+	_, err := Try(func() int {
+		return MustCast[int](any(3.14))
+	})
+
+	var ce *CastError
+	if !errors.As(err, &ce) {
+		t.Fatalf("Expected panic with *CastError, got %v", err)
+	}
+	if ce.Actual.String() != "float64" {
+		t.Errorf("Expected actual type float64, got %v", ce.Actual)
+	}
+}
+
+func TestCastOr(t *testing.T) {
+	// Test that CastOr falls back to the default on mismatch
+	if v := CastOr(any(42), 100); v != 42 {
+		t.Errorf("Expected 42, got %d", v)
+	}
+	if v := CastOr(any("42"), 100); v != 100 {
+		t.Errorf("Expected default 100, got %d", v)
+	}
+	if v := CastOr(nil, "default"); v != "default" {
+		t.Errorf("Expected default, got %q", v)
+	}
+}
+
+func TestCastSlice(t *testing.T) {
+	// Test that CastSlice converts every element and reports the first mismatch
+	t.Run("match", func(t *testing.T) {
+		v, err := CastSlice[string]([]any{"a", "b"})
+		if err != nil || len(v) != 2 || v[0] != "a" || v[1] != "b" {
+			t.Errorf("Expected [a b], got (%q, %v)", v, err)
+		}
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		v, err := CastSlice[string]([]any{"a", "b", 3.0, 4})
+		if v != nil {
+			t.Errorf("Expected nil slice, got %q", v)
+		}
+
+		var ce *CastError
+		if !errors.As(err, &ce) {
+			t.Fatalf("Expected *CastError, got %v", err)
+		}
+		if ce.Index != 2 {
+			t.Errorf("Expected index 2, got %d", ce.Index)
+		}
+		expected := "sugar: element 2: expected string, got float64"
+		if err.Error() != expected {
+			t.Errorf("Expected error message %q, got %q", expected, err.Error())
+		}
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		if v, err := CastSlice[int](nil); err != nil || v != nil {
+			t.Errorf("Expected (nil, nil), got (%v, %v)", v, err)
+		}
+	})
+}
+
+func TestCastMap(t *testing.T) {
+	// Test that CastMap converts every value and reports a mismatching key
+	t.Run("match", func(t *testing.T) {
+		v, err := CastMap[string, int](map[string]any{"a": 1, "b": 2})
+		if err != nil || len(v) != 2 || v["a"] != 1 || v["b"] != 2 {
+			t.Errorf("Expected map[a:1 b:2], got (%v, %v)", v, err)
+		}
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		_, err := CastMap[string, int](map[string]any{"a": 1, "b": "two"})
+
+		var ce *CastError
+		if !errors.As(err, &ce) {
+			t.Fatalf("Expected *CastError, got %v", err)
+		}
+		if ce.Key != "b" {
+			t.Errorf("Expected key b, got %v", ce.Key)
+		}
+		expected := "sugar: key b: expected int, got string"
+		if err.Error() != expected {
+			t.Errorf("Expected error message %q, got %q", expected, err.Error())
+		}
+	})
+}
+
+// Benchmark tests
+func BenchmarkCast_Mismatch(b *testing.B) {
+	var v any = "string value"
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Cast[int](v)
+	}
+}
+
+func BenchmarkCast_vs_Try(b *testing.B) {
+	var v any = "string value"
+
+	b.Run("cast", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Cast[int](v)
+		}
+	})
+
+	b.Run("try", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Try(func() int { return v.(int) })
+		}
+	})
+}
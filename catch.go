@@ -0,0 +1,71 @@
+package sugar
+
+// checked is the private panic value used by the Check family. Wrapping the
+// error lets Catch tell an error raised by Check apart from every other panic,
+// including a panic(err) made by Must or by unrelated code.
+//
+// checked is itself an error that unwraps to the error passed to Check, so a
+// Check panic that escapes to Try, or to a test helper expecting an error,
+// still matches that error with errors.Is and errors.As.
+type checked struct {
+	err error
+}
+
+func (c checked) Error() string { return c.err.Error() }
+
+func (c checked) Unwrap() error { return c.err }
+
+// Check panics if err is non-nil, in a way that only Catch recovers. Together
+// with Catch it provides scoped error propagation similar to the "?" operator
+// of other languages: the body of a function is written in straight-line
+// style, and the first failing Check returns its error through the function's
+// named error result.
+//
+// Check must only be used in functions that defer Catch; otherwise the panic
+// escapes like any other.
+//
+// Example usage:
+//
+//	func loadConfig(path string) (cfg Config, err error) {
+//	    defer Catch(&err)
+//
+//	    data := Check1(os.ReadFile(path))
+//	    Check(json.Unmarshal(data, &cfg))
+//	    return cfg, nil
+//	}
+func Check(err error) {
+	if err != nil {
+		panic(checked{err})
+	}
+}
+
+// Check1 returns v if err is nil, and otherwise panics like Check.
+func Check1[T any](v T, err error) T {
+	Check(err)
+	return v
+}
+
+// Check2 returns v1 and v2 if err is nil, and otherwise panics like Check.
+func Check2[T1, T2 any](v1 T1, v2 T2, err error) (T1, T2) {
+	Check(err)
+	return v1, v2
+}
+
+// Catch recovers a panic raised by Check and stores its error in *errp. It
+// must be called directly by defer, typically as the first statement of a
+// function with a named error result:
+//
+//	defer Catch(&err)
+//
+// Any other panic, including runtime errors and panics raised by Must, is not
+// handled and continues to unwind the stack, so genuine bugs are never turned
+// into ordinary errors.
+func Catch(errp *error) {
+	if r := recover(); r != nil {
+		c, ok := r.(checked)
+		if !ok {
+			panic(r)
+		}
+		*errp = c.err
+	}
+}
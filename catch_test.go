@@ -2,6 +2,8 @@ package sugar
 
 import (
 	"errors"
+	"io"
+	"runtime"
 	"testing"
 )
 
@@ -199,6 +201,214 @@ func TestHandle_MultipleHandlers(t *testing.T) {
 	handler2("test", errors.New("some error"))
 }
 
+func TestHandleWith_NoError(t *testing.T) {
+	// Test that the recoverer is not called when there's no error
+	handler := HandleWith(func(v string, err error) (string, error) {
+		t.Fatal("Recoverer should not be called when there's no error")
+		return v, err
+	})
+
+	if result := handler("test value", nil); result != "test value" {
+		t.Errorf("Expected %q, got %q", "test value", result)
+	}
+}
+
+func TestHandleWith_ReplacementValue(t *testing.T) {
+	// Test that the recoverer can replace the value returned on error
+	originalErr := errors.New("original error")
+
+	handler := HandleWith(func(v int, err error) (int, error) {
+		if v != -1 {
+			t.Errorf("Expected recoverer to receive -1, got %d", v)
+		}
+		if err != originalErr {
+			t.Errorf("Expected recoverer to receive %v, got %v", originalErr, err)
+		}
+		return 8080, nil
+	})
+
+	if result := handler(-1, originalErr); result != 8080 {
+		t.Errorf("Expected 8080, got %d", result)
+	}
+}
+
+func TestHandleWith_RecovererReturnsError(t *testing.T) {
+	// Test that an error returned by the recoverer causes a panic
+	recovererErr := errors.New("recoverer error")
+	handler := HandleWith(func(v string, err error) (string, error) {
+		return "ignored", recovererErr
+	})
+
+	defer func() {
+		if r := recover(); r != recovererErr {
+			t.Errorf("Expected panic with %v, got %v", recovererErr, r)
+		}
+	}()
+
+	handler("test", errors.New("original error"))
+	t.Error("Expected function to panic, but it didn't")
+}
+
+func TestFallback(t *testing.T) {
+	// Test that Fallback returns the default only for handled errors
+	ignored := errors.New("ignored")
+	handler := HandleWith(Fallback(func(err error) error {
+		if err == ignored {
+			return nil
+		}
+		return err
+	}, "default"))
+
+	if result := handler("partial", ignored); result != "default" {
+		t.Errorf("Expected %q, got %q", "default", result)
+	}
+	if result := handler("value", nil); result != "value" {
+		t.Errorf("Expected %q, got %q", "value", result)
+	}
+
+	otherErr := errors.New("other")
+	defer func() {
+		if r := recover(); r != otherErr {
+			t.Errorf("Expected panic with %v, got %v", otherErr, r)
+		}
+	}()
+
+	handler("partial", otherErr)
+	t.Error("Expected function to panic, but it didn't")
+}
+
+func TestCatch_NoError(t *testing.T) {
+	// Test that a function whose checks all pass returns normally
+	f := func() (result int, err error) {
+		defer Catch(&err)
+
+		v := Check1(21, nil)
+		Check(nil)
+		return v * 2, nil
+	}
+
+	result, err := f()
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if result != 42 {
+		t.Errorf("Expected 42, got %d", result)
+	}
+}
+
+func TestCatch_CheckError(t *testing.T) {
+	// Test that the first failing Check returns its error through the named result
+	firstErr := errors.New("first error")
+	secondErr := errors.New("second error")
+	reached := false
+
+	f := func() (err error) {
+		defer Catch(&err)
+
+		Check(firstErr)
+		reached = true
+		Check(secondErr)
+		return nil
+	}
+
+	err := f()
+	if err != firstErr {
+		t.Errorf("Expected %v, got %v", firstErr, err)
+	}
+	if reached {
+		t.Error("Expected execution to stop at the first failing Check")
+	}
+}
+
+func TestCatch_CheckVariants(t *testing.T) {
+	// Test the value-returning Check variants
+	testErr := errors.New("test error")
+
+	t.Run("check1", func(t *testing.T) {
+		f := func() (s string, err error) {
+			defer Catch(&err)
+			return Check1("value", testErr), nil
+		}
+
+		s, err := f()
+		if err != testErr {
+			t.Errorf("Expected %v, got %v", testErr, err)
+		}
+		if s != "" {
+			t.Errorf("Expected empty string, got %q", s)
+		}
+	})
+
+	t.Run("check2", func(t *testing.T) {
+		f := func() (sum int, err error) {
+			defer Catch(&err)
+			a, b := Check2(1, 2, nil)
+			return a + b, nil
+		}
+
+		sum, err := f()
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if sum != 3 {
+			t.Errorf("Expected 3, got %d", sum)
+		}
+	})
+}
+
+func TestCatch_RepanicsOtherPanics(t *testing.T) {
+	// Test that panics not raised by Check are not swallowed
+	t.Run("must_panic", func(t *testing.T) {
+		mustErr := errors.New("must error")
+		f := func() (err error) {
+			defer Catch(&err)
+			Must(0, mustErr)
+			return nil
+		}
+
+		defer func() {
+			if r := recover(); r != mustErr {
+				t.Errorf("Expected panic with %v, got %v", mustErr, r)
+			}
+		}()
+
+		f()
+		t.Error("Expected function to panic, but it didn't")
+	})
+
+	t.Run("runtime_panic", func(t *testing.T) {
+		f := func() (err error) {
+			defer Catch(&err)
+			var m map[string]int
+			m["key"] = 1
+			return nil
+		}
+
+		defer func() {
+			if _, ok := recover().(runtime.Error); !ok {
+				t.Error("Expected runtime error panic to propagate")
+			}
+		}()
+
+		f()
+		t.Error("Expected function to panic, but it didn't")
+	})
+}
+
+func TestCheck_EscapesAsError(t *testing.T) {
+	// Test that a Check panic outside Catch still matches its error
+	err := Try0(func() {
+		Check(io.EOF)
+	})
+
+	if !errors.Is(err, io.EOF) {
+		t.Errorf("Expected errors.Is(err, io.EOF) to be true, err=%v", err)
+	}
+	if err.Error() != "panic: EOF" {
+		t.Errorf("Expected error message %q, got %q", "panic: EOF", err.Error())
+	}
+}
+
 // Benchmark tests
 func BenchmarkHandle_NoError(b *testing.B) {
 	handler := Handle[int](func(err error) error { return nil })
@@ -218,3 +428,17 @@ func BenchmarkHandle_WithErrorHandled(b *testing.B) {
 		handler(42, err)
 	}
 }
+
+func BenchmarkCatch_CheckError(b *testing.B) {
+	testErr := errors.New("test error")
+	f := func() (err error) {
+		defer Catch(&err)
+		Check(testErr)
+		return nil
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		f()
+	}
+}
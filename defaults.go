@@ -0,0 +1,81 @@
+package sugar
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ApplyDefaults fills the fields of the struct that ptr points to from their
+// `default` struct tags. A field is only filled while it still holds its zero
+// value, in the same sense as Zero, so values that were already set are never
+// overwritten.
+//
+// Tags are parsed like Parse does, so the supported field types are:
+//   - strings, booleans, integers, unsigned integers and floats
+//   - time.Duration, time.Time, url.URL and the net/netip types
+//   - types with a parser registered through RegisterParser
+//   - any type whose pointer implements encoding.TextUnmarshaler
+//   - slices of the above, parsed from a comma-separated list
+//   - pointers to the above, which are allocated like Ptr does
+//
+// Nested structs and non-nil pointers to structs without a default tag are
+// walked recursively. Unexported fields are ignored.
+//
+// Every tag that cannot be parsed is reported, including tags of fields that
+// are already set; the returned error joins one error per bad field, each
+// naming the field's dotted path. Fields whose tags parse correctly are still
+// filled. ApplyDefaults also returns an error if ptr is not a non-nil pointer
+// to a struct.
+//
+// Example usage:
+//
+//	type Config struct {
+//	    Host    string        `default:"localhost"`
+//	    Port    int           `default:"8080"`
+//	    Timeout time.Duration `default:"30s"`
+//	    Debug   *bool         `default:"false"`
+//	    Tags    []string      `default:"web,api"`
+//	}
+//	var cfg Config
+//	Must0(ApplyDefaults(&cfg))
+func ApplyDefaults(ptr any) error {
+	v := reflect.ValueOf(ptr)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("sugar: ApplyDefaults requires a non-nil pointer to a struct, got %T", ptr)
+	}
+	var errs []error
+	applyDefaults(v.Elem(), "", &errs)
+	return errors.Join(errs...)
+}
+
+func applyDefaults(v reflect.Value, prefix string, errs *[]error) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		f := v.Field(i)
+		path := prefix + field.Name
+
+		if tag, ok := field.Tag.Lookup("default"); ok {
+			// Parse every tag, even for fields that are already set, so that
+			// a bad tag is reported before it is ever needed.
+			def := reflect.New(f.Type()).Elem()
+			if err := setString(def, tag); err != nil {
+				*errs = append(*errs, fmt.Errorf("sugar: field %s: invalid default %q: %w", path, tag, err))
+			} else if f.IsZero() {
+				f.Set(def)
+			}
+			continue
+		}
+
+		switch {
+		case f.Kind() == reflect.Struct:
+			applyDefaults(f, path+".", errs)
+		case f.Kind() == reflect.Pointer && !f.IsNil() && f.Elem().Kind() == reflect.Struct:
+			applyDefaults(f.Elem(), path+".", errs)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package sugar
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+type defaultsServer struct {
+	Addr    string        `default:":8080"`
+	Timeout time.Duration `default:"30s"`
+}
+
+type defaultsConfig struct {
+	Name     string        `default:"app"`
+	Port     int           `default:"8080"`
+	Workers  uint8         `default:"4"`
+	Ratio    float64       `default:"0.5"`
+	Debug    bool          `default:"true"`
+	Interval time.Duration `default:"1m30s"`
+	Tags     []string      `default:"web, api"`
+	Ports    []int         `default:"80,443"`
+	Retries  *int          `default:"3"`
+	IP       net.IP        `default:"127.0.0.1"`
+	Server   defaultsServer
+	Backup   *defaultsServer
+	NoTag    string
+	internal string `default:"ignored"`
+}
+
+func TestApplyDefaults_BasicKinds(t *testing.T) {
+	// Test that every supported field type is filled from its tag
+	var cfg defaultsConfig
+	if err := ApplyDefaults(&cfg); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if cfg.Name != "app" {
+		t.Errorf("Expected Name %q, got %q", "app", cfg.Name)
+	}
+	if cfg.Port != 8080 {
+		t.Errorf("Expected Port 8080, got %d", cfg.Port)
+	}
+	if cfg.Workers != 4 {
+		t.Errorf("Expected Workers 4, got %d", cfg.Workers)
+	}
+	if cfg.Ratio != 0.5 {
+		t.Errorf("Expected Ratio 0.5, got %f", cfg.Ratio)
+	}
+	if cfg.Debug != true {
+		t.Errorf("Expected Debug true, got %t", cfg.Debug)
+	}
+	if cfg.Interval != 90*time.Second {
+		t.Errorf("Expected Interval 1m30s, got %v", cfg.Interval)
+	}
+	if len(cfg.Tags) != 2 || cfg.Tags[0] != "web" || cfg.Tags[1] != "api" {
+		t.Errorf("Expected Tags [web api], got %q", cfg.Tags)
+	}
+	if len(cfg.Ports) != 2 || cfg.Ports[0] != 80 || cfg.Ports[1] != 443 {
+		t.Errorf("Expected Ports [80 443], got %v", cfg.Ports)
+	}
+	if cfg.Retries == nil || *cfg.Retries != 3 {
+		t.Errorf("Expected Retries to point to 3, got %v", cfg.Retries)
+	}
+	if !cfg.IP.Equal(net.IPv4(127, 0, 0, 1)) {
+		t.Errorf("Expected IP 127.0.0.1, got %v", cfg.IP)
+	}
+	if cfg.NoTag != "" {
+		t.Errorf("Expected NoTag to stay empty, got %q", cfg.NoTag)
+	}
+	if cfg.internal != "" {
+		t.Errorf("Expected unexported field to be ignored, got %q", cfg.internal)
+	}
+}
+
+func TestApplyDefaults_KeepsSetValues(t *testing.T) {
+	// Test that fields which are no longer zero are not overwritten
+	cfg := defaultsConfig{
+		Name:    "custom",
+		Port:    9090,
+		Retries: Ptr(0),
+		Tags:    []string{},
+	}
+	if err := ApplyDefaults(&cfg); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if cfg.Name != "custom" {
+		t.Errorf("Expected Name to be kept, got %q", cfg.Name)
+	}
+	if cfg.Port != 9090 {
+		t.Errorf("Expected Port to be kept, got %d", cfg.Port)
+	}
+	if cfg.Retries == nil || *cfg.Retries != 0 {
+		t.Errorf("Expected Retries to keep pointing to 0, got %v", cfg.Retries)
+	}
+	if cfg.Tags == nil || len(cfg.Tags) != 0 {
+		t.Errorf("Expected empty non-nil Tags to be kept, got %q", cfg.Tags)
+	}
+}
+
+func TestApplyDefaults_NestedStructs(t *testing.T) {
+	// Test that nested structs and non-nil struct pointers are walked
+	cfg := defaultsConfig{Backup: &defaultsServer{Addr: ":9090"}}
+	if err := ApplyDefaults(&cfg); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if cfg.Server.Addr != ":8080" || cfg.Server.Timeout != 30*time.Second {
+		t.Errorf("Expected Server {:8080 30s}, got %+v", cfg.Server)
+	}
+	if cfg.Backup.Addr != ":9090" || cfg.Backup.Timeout != 30*time.Second {
+		t.Errorf("Expected Backup {:9090 30s}, got %+v", *cfg.Backup)
+	}
+
+	var empty defaultsConfig
+	if err := ApplyDefaults(&empty); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if empty.Backup != nil {
+		t.Errorf("Expected nil struct pointer to stay nil, got %+v", empty.Backup)
+	}
+}
+
+func TestApplyDefaults_InvalidTags(t *testing.T) {
+	// Test that every unparsable tag is reported and valid tags are still applied
+	type badConfig struct {
+		Port    int           `default:"eighty"`
+		Valid   string        `default:"ok"`
+		Timeout time.Duration `default:"soon"`
+		Ports   []int         `default:"80,x"`
+		Nested  struct {
+			Flag bool `default:"maybe"`
+		}
+	}
+
+	var cfg badConfig
+	err := ApplyDefaults(&cfg)
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	for _, field := range []string{"Port", "Timeout", "Ports", "Nested.Flag"} {
+		if !strings.Contains(err.Error(), "field "+field+":") {
+			t.Errorf("Expected error to mention field %s, got %v", field, err)
+		}
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); !ok || len(joined.Unwrap()) != 4 {
+		t.Errorf("Expected 4 joined errors, got %v", err)
+	}
+	if cfg.Valid != "ok" {
+		t.Errorf("Expected valid tag to be applied, got %q", cfg.Valid)
+	}
+}
+
+func TestApplyDefaults_InvalidTagOnSetField(t *testing.T) {
+	// Test that a bad tag is reported even when the field is already set
+	cfg := struct {
+		Port int `default:"abc"`
+	}{Port: 5}
+
+	err := ApplyDefaults(&cfg)
+	if err == nil || !strings.Contains(err.Error(), "field Port:") {
+		t.Errorf("Expected error mentioning field Port, got %v", err)
+	}
+	if cfg.Port != 5 {
+		t.Errorf("Expected set value 5 to be kept, got %d", cfg.Port)
+	}
+}
+
+func TestApplyDefaults_InvalidArgument(t *testing.T) {
+	// Test that non-struct-pointer arguments are rejected
+	var nilPtr *defaultsConfig
+	for _, arg := range []any{nil, defaultsConfig{}, nilPtr, Ptr(42)} {
+		if err := ApplyDefaults(arg); err == nil {
+			t.Errorf("Expected error for %T, got nil", arg)
+		}
+	}
+
+	type unsupported struct {
+		Ch chan int `default:"1"`
+	}
+	err := ApplyDefaults(&unsupported{})
+	if err == nil || !strings.Contains(err.Error(), "unsupported type") {
+		t.Errorf("Expected unsupported type error, got %v", err)
+	}
+}
+
+// Benchmark tests
+func BenchmarkApplyDefaults(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var cfg defaultsConfig
+		ApplyDefaults(&cfg)
+	}
+}
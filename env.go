@@ -0,0 +1,116 @@
+package sugar
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+)
+
+// ErrEnvNotSet is reported (wrapped in an *EnvError) when a required
+// environment variable is not set.
+var ErrEnvNotSet = errors.New("not set")
+
+// EnvError describes an environment variable that is missing or could not be
+// parsed. It names both the variable and the offending value, which a bare
+// Must(strconv.Atoi(os.Getenv("PORT"))) loses.
+type EnvError struct {
+	// Name is the name of the environment variable.
+	Name string
+
+	// Value is the raw value of the variable, or "" if it is not set.
+	Value string
+
+	// Err is ErrEnvNotSet or the error returned by the parser.
+	Err error
+}
+
+// Error formats the error, including the variable name and value.
+func (e *EnvError) Error() string {
+	if errors.Is(e.Err, ErrEnvNotSet) {
+		return fmt.Sprintf("sugar: environment variable %s is not set", e.Name)
+	}
+	return fmt.Sprintf("sugar: environment variable %s=%q: %v", e.Name, e.Value, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *EnvError) Unwrap() error {
+	return e.Err
+}
+
+// EnvOption configures Env, MustEnv and EnvOr.
+type EnvOption func(*envConfig)
+
+type envConfig struct {
+	lookup func(string) (string, bool)
+}
+
+// WithEnvLookup replaces os.LookupEnv as the source of environment variables,
+// which lets tests supply values without touching the process environment:
+//
+//	lookup := func(name string) (string, bool) {
+//	    v, ok := map[string]string{"PORT": "8080"}[name]
+//	    return v, ok
+//	}
+//	port, err := Env[int]("PORT", WithEnvLookup(lookup))
+func WithEnvLookup(lookup func(name string) (string, bool)) EnvOption {
+	return func(c *envConfig) {
+		c.lookup = lookup
+	}
+}
+
+// Env looks up the environment variable name and parses it as a T with the
+// same rules as Parse, so it supports strings, booleans, numbers,
+// time.Duration, url.URL, registered parsers, any encoding.TextUnmarshaler,
+// pointers to those, and slices of those given as a comma-separated list.
+//
+// Unlike os.Getenv, an unset variable is an error rather than "". All errors
+// are of type *EnvError; errors.Is(err, ErrEnvNotSet) reports a missing
+// variable.
+//
+// Example usage:
+//
+//	port, err := Env[int]("PORT")
+//	if err != nil {
+//	    return err // sugar: environment variable PORT="eighty": ...
+//	}
+//	timeout, err := Env[time.Duration]("TIMEOUT")
+//	hosts, err := Env[[]string]("ALLOWED_HOSTS")
+func Env[T any](name string, opts ...EnvOption) (T, error) {
+	c := envConfig{lookup: os.LookupEnv}
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	s, ok := c.lookup(name)
+	if !ok {
+		return Zero[T](), &EnvError{Name: name, Err: ErrEnvNotSet}
+	}
+
+	var v T
+	if err := setString(reflect.ValueOf(&v).Elem(), s); err != nil {
+		return Zero[T](), &EnvError{Name: name, Value: s, Err: err}
+	}
+	return v, nil
+}
+
+// MustEnv is like Env but panics with the *EnvError if the variable is unset
+// or invalid. It is meant for startup code where a missing setting is fatal:
+//
+//	var port = MustEnv[int]("PORT")
+func MustEnv[T any](name string, opts ...EnvOption) T {
+	return Must(Env[T](name, opts...))
+}
+
+// EnvOr is like Env but returns def if the variable is unset or cannot be
+// parsed. Use Env instead when an invalid value should be reported rather
+// than silently replaced.
+//
+//	timeout := EnvOr("TIMEOUT", 30*time.Second)
+func EnvOr[T any](name string, def T, opts ...EnvOption) T {
+	v, err := Env[T](name, opts...)
+	if err != nil {
+		return def
+	}
+	return v
+}
@@ -0,0 +1,185 @@
+package sugar
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testEnvLookup(env map[string]string) EnvOption {
+	return WithEnvLookup(func(name string) (string, bool) {
+		v, ok := env[name]
+		return v, ok
+	})
+}
+
+func TestEnv_Types(t *testing.T) {
+	// Test parsing of the supported types
+	lookup := testEnvLookup(map[string]string{
+		"NAME":    "app",
+		"PORT":    "8080",
+		"DEBUG":   "true",
+		"RATIO":   "0.25",
+		"TIMEOUT": "1m30s",
+		"URL":     "https://example.com/path",
+		"HOSTS":   "a.example.com, b.example.com",
+		"PORTS":   "80,443",
+		"EMPTY":   "",
+	})
+
+	t.Run("string", func(t *testing.T) {
+		if v, err := Env[string]("NAME", lookup); err != nil || v != "app" {
+			t.Errorf("Expected (app, nil), got (%q, %v)", v, err)
+		}
+	})
+
+	t.Run("int", func(t *testing.T) {
+		if v, err := Env[int]("PORT", lookup); err != nil || v != 8080 {
+			t.Errorf("Expected (8080, nil), got (%d, %v)", v, err)
+		}
+	})
+
+	t.Run("bool", func(t *testing.T) {
+		if v, err := Env[bool]("DEBUG", lookup); err != nil || v != true {
+			t.Errorf("Expected (true, nil), got (%t, %v)", v, err)
+		}
+	})
+
+	t.Run("float", func(t *testing.T) {
+		if v, err := Env[float64]("RATIO", lookup); err != nil || v != 0.25 {
+			t.Errorf("Expected (0.25, nil), got (%f, %v)", v, err)
+		}
+	})
+
+	t.Run("duration", func(t *testing.T) {
+		if v, err := Env[time.Duration]("TIMEOUT", lookup); err != nil || v != 90*time.Second {
+			t.Errorf("Expected (1m30s, nil), got (%v, %v)", v, err)
+		}
+	})
+
+	t.Run("url", func(t *testing.T) {
+		v, err := Env[*url.URL]("URL", lookup)
+		if err != nil || v.Host != "example.com" || v.Path != "/path" {
+			t.Errorf("Expected https://example.com/path, got (%v, %v)", v, err)
+		}
+	})
+
+	t.Run("string_slice", func(t *testing.T) {
+		v, err := Env[[]string]("HOSTS", lookup)
+		if err != nil || len(v) != 2 || v[0] != "a.example.com" || v[1] != "b.example.com" {
+			t.Errorf("Expected [a.example.com b.example.com], got (%q, %v)", v, err)
+		}
+	})
+
+	t.Run("int_slice", func(t *testing.T) {
+		v, err := Env[[]int]("PORTS", lookup)
+		if err != nil || len(v) != 2 || v[0] != 80 || v[1] != 443 {
+			t.Errorf("Expected [80 443], got (%v, %v)", v, err)
+		}
+	})
+
+	t.Run("text_unmarshaler", func(t *testing.T) {
+		lookup := testEnvLookup(map[string]string{"START": "2024-01-02T03:04:05Z"})
+		v, err := Env[time.Time]("START", lookup)
+		expected := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		if err != nil || !v.Equal(expected) {
+			t.Errorf("Expected (%v, nil), got (%v, %v)", expected, v, err)
+		}
+	})
+
+	t.Run("empty_string", func(t *testing.T) {
+		if v, err := Env[string]("EMPTY", lookup); err != nil || v != "" {
+			t.Errorf("Expected (\"\", nil), got (%q, %v)", v, err)
+		}
+	})
+}
+
+func TestEnv_Errors(t *testing.T) {
+	// Test that errors name the variable and the offending value
+	lookup := testEnvLookup(map[string]string{"PORT": "eighty"})
+
+	t.Run("not_set", func(t *testing.T) {
+		_, err := Env[int]("MISSING", lookup)
+		if !errors.Is(err, ErrEnvNotSet) {
+			t.Errorf("Expected ErrEnvNotSet, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "MISSING") {
+			t.Errorf("Expected error to name the variable, got %q", err.Error())
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		v, err := Env[int]("PORT", lookup)
+		if v != 0 {
+			t.Errorf("Expected zero value, got %d", v)
+		}
+
+		var envErr *EnvError
+		if !errors.As(err, &envErr) {
+			t.Fatalf("Expected *EnvError, got %v", err)
+		}
+		if envErr.Name != "PORT" || envErr.Value != "eighty" {
+			t.Errorf("Expected PORT=eighty, got %s=%s", envErr.Name, envErr.Value)
+		}
+		expected := `sugar: environment variable PORT="eighty": `
+		if !strings.HasPrefix(err.Error(), expected) {
+			t.Errorf("Expected error message to start with %q, got %q", expected, err.Error())
+		}
+	})
+}
+
+func TestEnv_ProcessEnvironment(t *testing.T) {
+	// Test that os.LookupEnv is used by default
+	t.Setenv("SUGAR_TEST_PORT", "9090")
+
+	if v, err := Env[int]("SUGAR_TEST_PORT"); err != nil || v != 9090 {
+		t.Errorf("Expected (9090, nil), got (%d, %v)", v, err)
+	}
+}
+
+func TestMustEnv(t *testing.T) {
+	// Test that MustEnv returns the value or panics with *EnvError
+	lookup := testEnvLookup(map[string]string{"PORT": "8080"})
+
+	if v := MustEnv[int]("PORT", lookup); v != 8080 {
+		t.Errorf("Expected 8080, got %d", v)
+	}
+
+	defer func() {
+		err, _ := recover().(error)
+		var envErr *EnvError
+		if !errors.As(err, &envErr) || envErr.Name != "MISSING" {
+			t.Errorf("Expected panic with *EnvError for MISSING, got %v", err)
+		}
+	}()
+
+	MustEnv[int]("MISSING", lookup)
+	t.Error("Expected function to panic, but it didn't")
+}
+
+func TestEnvOr(t *testing.T) {
+	// Test that EnvOr falls back to the default when unset or invalid
+	lookup := testEnvLookup(map[string]string{"PORT": "8080", "BAD": "x"})
+
+	if v := EnvOr("PORT", 1, lookup); v != 8080 {
+		t.Errorf("Expected 8080, got %d", v)
+	}
+	if v := EnvOr("MISSING", 1, lookup); v != 1 {
+		t.Errorf("Expected default 1, got %d", v)
+	}
+	if v := EnvOr("BAD", 1, lookup); v != 1 {
+		t.Errorf("Expected default 1, got %d", v)
+	}
+}
+
+// Benchmark tests
+func BenchmarkEnv_Int(b *testing.B) {
+	lookup := testEnvLookup(map[string]string{"PORT": "8080"})
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Env[int]("PORT", lookup)
+	}
+}
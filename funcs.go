@@ -0,0 +1,70 @@
+package sugar
+
+// MustFunc adapts a function returning (B, error) into one returning only B,
+// by applying Must to every call. It lets fallible functions be passed where
+// an infallible signature is expected, such as template functions or
+// slices.SortFunc keys, when an error should be fatal.
+//
+// Example usage:
+//
+//	parse := MustFunc(strconv.Atoi)
+//	slices.SortFunc(ids, func(a, b string) int {
+//	    return parse(a) - parse(b)
+//	})
+func MustFunc[A, B any](f func(A) (B, error)) func(A) B {
+	return func(a A) B {
+		return Must(f(a))
+	}
+}
+
+// MustFunc2 is the two-argument variant of MustFunc.
+//
+//	rel := MustFunc2(filepath.Rel)
+//	path := rel("/srv/app", "/srv/app/static/logo.png") // "static/logo.png"
+func MustFunc2[A1, A2, B any](f func(A1, A2) (B, error)) func(A1, A2) B {
+	return func(a1 A1, a2 A2) B {
+		return Must(f(a1, a2))
+	}
+}
+
+// TryFunc adapts a function that may panic into one returning (B, error), by
+// running every call under Try. Panics are returned as *PanicError.
+//
+// Example usage:
+//
+//	safeProcess := TryFunc(thirdparty.Process)
+//	out, err := safeProcess(input)
+func TryFunc[A, B any](f func(A) B) func(A) (B, error) {
+	return func(a A) (B, error) {
+		return Try(func() B { return f(a) })
+	}
+}
+
+// TryFunc2 is the two-argument variant of TryFunc.
+func TryFunc2[A1, A2, B any](f func(A1, A2) B) func(A1, A2) (B, error) {
+	return func(a1 A1, a2 A2) (B, error) {
+		return Try(func() B { return f(a1, a2) })
+	}
+}
+
+// HandleFunc adapts a function returning (B, error) into one returning only
+// B, by passing every result through Handle with h.
+//
+// Example usage:
+//
+//	readOptional := HandleFunc(os.ReadFile, IgnoreIs[[]byte](fs.ErrNotExist))
+//	data := readOptional("override.json") // nil if the file does not exist
+func HandleFunc[A, B any](f func(A) (B, error), h Handler[B]) func(A) B {
+	handle := Handle(h)
+	return func(a A) B {
+		return handle(f(a))
+	}
+}
+
+// HandleFunc2 is the two-argument variant of HandleFunc.
+func HandleFunc2[A1, A2, B any](f func(A1, A2) (B, error), h Handler[B]) func(A1, A2) B {
+	handle := Handle(h)
+	return func(a1 A1, a2 A2) B {
+		return handle(f(a1, a2))
+	}
+}
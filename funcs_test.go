@@ -0,0 +1,125 @@
+package sugar
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestMustFunc(t *testing.T) {
+	// Test that MustFunc returns values and panics on errors
+	atoi := MustFunc(strconv.Atoi)
+
+	if v := atoi("42"); v != 42 {
+		t.Errorf("Expected 42, got %d", v)
+	}
+
+	defer func() {
+		var numErr *strconv.NumError
+		if err, ok := recover().(error); !ok || !errors.As(err, &numErr) {
+			t.Errorf("Expected panic with *strconv.NumError, got %v", err)
+		}
+	}()
+
+	atoi("not a number")
+	t.Error("Expected function to panic, but it didn't")
+}
+
+func TestMustFunc2(t *testing.T) {
+	// Test the two-argument variant
+	parse := MustFunc2(func(s string, base int) (int64, error) {
+		return strconv.ParseInt(s, base, 64)
+	})
+
+	if v := parse("ff", 16); v != 255 {
+		t.Errorf("Expected 255, got %d", v)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected function to panic, but it didn't")
+		}
+	}()
+
+	parse("zz", 16)
+}
+
+func TestTryFunc(t *testing.T) {
+	// Test that TryFunc converts panics into errors
+	index := TryFunc(func(i int) string {
+		return []string{"a", "b"}[i]
+	})
+
+	if v, err := index(1); err != nil || v != "b" {
+		t.Errorf("Expected (b, nil), got (%q, %v)", v, err)
+	}
+
+	v, err := index(5)
+	if v != "" {
+		t.Errorf("Expected zero value, got %q", v)
+	}
+	var pe *PanicError
+	if !errors.As(err, &pe) {
+		t.Errorf("Expected *PanicError, got %v", err)
+	}
+}
+
+func TestTryFunc2(t *testing.T) {
+	// Test the two-argument variant
+	divide := TryFunc2(func(a, b int) int { return a / b })
+
+	if v, err := divide(10, 2); err != nil || v != 5 {
+		t.Errorf("Expected (5, nil), got (%d, %v)", v, err)
+	}
+	if v, err := divide(10, 0); err == nil || v != 0 {
+		t.Errorf("Expected (0, error), got (%d, %v)", v, err)
+	}
+}
+
+func TestHandleFunc(t *testing.T) {
+	// Test that HandleFunc applies the handler to every call
+	atoi := HandleFunc(strconv.Atoi, IgnoreAs[int, *strconv.NumError]())
+
+	if v := atoi("42"); v != 42 {
+		t.Errorf("Expected 42, got %d", v)
+	}
+	if v := atoi("not a number"); v != 0 {
+		t.Errorf("Expected 0 for handled error, got %d", v)
+	}
+
+	testErr := errors.New("test error")
+	failing := HandleFunc(func(s string) (int, error) { return 0, testErr }, IgnoreAs[int, *strconv.NumError]())
+
+	defer func() {
+		if r := recover(); r != testErr {
+			t.Errorf("Expected panic with %v, got %v", testErr, r)
+		}
+	}()
+
+	failing("x")
+	t.Error("Expected function to panic, but it didn't")
+}
+
+func TestHandleFunc2(t *testing.T) {
+	// Test the two-argument variant
+	parse := HandleFunc2(func(s string, base int) (int64, error) {
+		return strconv.ParseInt(s, base, 64)
+	}, func(err error) error { return nil })
+
+	if v := parse("ff", 16); v != 255 {
+		t.Errorf("Expected 255, got %d", v)
+	}
+	if v := parse("zz", 16); v != 0 {
+		t.Errorf("Expected 0 for handled error, got %d", v)
+	}
+}
+
+// Benchmark tests
+func BenchmarkMustFunc(b *testing.B) {
+	atoi := MustFunc(strconv.Atoi)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		atoi("42")
+	}
+}
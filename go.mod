@@ -0,0 +1,3 @@
+module github.com/dccarswell/sugar
+
+go 1.24
@@ -0,0 +1,118 @@
+package sugar
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Group runs tasks on their own goroutines and collects their errors, in the
+// spirit of golang.org/x/sync/errgroup. Unlike a bare go statement, every
+// task runs under Try-style recovery: a panicking task does not crash the
+// process but is reported from Wait as a *PanicError carrying the stack of the
+// goroutine that panicked.
+//
+// The zero value is a valid Group with no concurrency limit that does not
+// cancel anything on error. Use GroupWithContext to cancel sibling tasks when
+// one of them fails.
+//
+// Example usage:
+//
+//	g, ctx := GroupWithContext(ctx)
+//	g.SetLimit(8)
+//	for _, url := range urls {
+//	    g.Go(func() error {
+//	        return fetch(ctx, url)
+//	    })
+//	}
+//	if err := g.Wait(); err != nil {
+//	    var pe *PanicError
+//	    if errors.As(err, &pe) {
+//	        log.Printf("worker panicked:\n%s", pe.Stack)
+//	    }
+//	    return err
+//	}
+//
+// A Group must not be copied after first use.
+type Group struct {
+	cancel context.CancelCauseFunc
+	wg     sync.WaitGroup
+	sem    chan struct{}
+
+	mu   sync.Mutex
+	errs []error
+}
+
+// GroupWithContext returns a new Group and a context derived from ctx. The
+// derived context is cancelled the first time a task returns an error or
+// panics, with that error as its cause, or when Wait returns, whichever
+// happens first.
+func GroupWithContext(ctx context.Context) (*Group, context.Context) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	return &Group{cancel: cancel}, ctx
+}
+
+// SetLimit limits the number of tasks running at once to n. Go blocks while
+// the limit is reached. A negative n removes the limit. SetLimit must not be
+// called while tasks are running.
+func (g *Group) SetLimit(n int) {
+	if n < 0 {
+		g.sem = nil
+		return
+	}
+	if len(g.sem) != 0 {
+		panic("sugar: SetLimit called while tasks are running")
+	}
+	g.sem = make(chan struct{}, n)
+}
+
+// Go runs f on a new goroutine. If f returns an error or panics, the error is
+// recorded and returned from Wait, and the Group's context, if any, is
+// cancelled.
+func (g *Group) Go(f func() error) {
+	if g.sem != nil {
+		g.sem <- struct{}{}
+	}
+	g.wg.Add(1)
+	go func() {
+		defer g.done()
+
+		_, err := TryE(func() (struct{}, error) {
+			return struct{}{}, f()
+		})
+		if err != nil {
+			g.fail(err)
+		}
+	}()
+}
+
+// Wait blocks until every task started with Go has finished, then returns
+// all of their errors joined with errors.Join, or nil if every task
+// succeeded.
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	if g.cancel != nil {
+		g.cancel(nil)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return errors.Join(g.errs...)
+}
+
+func (g *Group) done() {
+	if g.sem != nil {
+		<-g.sem
+	}
+	g.wg.Done()
+}
+
+func (g *Group) fail(err error) {
+	g.mu.Lock()
+	g.errs = append(g.errs, err)
+	g.mu.Unlock()
+
+	if g.cancel != nil {
+		g.cancel(err)
+	}
+}
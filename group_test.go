@@ -0,0 +1,165 @@
+package sugar
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGroup_NoError(t *testing.T) {
+	// Test that Wait returns nil when every task succeeds
+	var g Group
+	var count atomic.Int32
+
+	for i := 0; i < 10; i++ {
+		g.Go(func() error {
+			count.Add(1)
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if count.Load() != 10 {
+		t.Errorf("Expected 10 tasks to run, got %d", count.Load())
+	}
+}
+
+func TestGroup_JoinsErrors(t *testing.T) {
+	// Test that Wait returns every task error joined together
+	err1 := errors.New("first error")
+	err2 := errors.New("second error")
+
+	var g Group
+	g.Go(func() error { return err1 })
+	g.Go(func() error { return nil })
+	g.Go(func() error { return err2 })
+
+	err := g.Wait()
+	if !errors.Is(err, err1) {
+		t.Errorf("Expected joined error to contain %v, got %v", err1, err)
+	}
+	if !errors.Is(err, err2) {
+		t.Errorf("Expected joined error to contain %v, got %v", err2, err)
+	}
+}
+
+func TestGroup_RecoversPanics(t *testing.T) {
+	// Test that a panicking task is reported as a *PanicError with its stack
+	var g Group
+	g.Go(func() error {
+		return groupPanickingTask()
+	})
+
+	err := g.Wait()
+
+	var pe *PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("Expected *PanicError, got %v", err)
+	}
+	if pe.Value != "task panic" {
+		t.Errorf("Expected panic value %q, got %v", "task panic", pe.Value)
+	}
+	if !strings.Contains(string(pe.Stack), "groupPanickingTask") {
+		t.Errorf("Expected stack to contain panicking frame, got:\n%s", pe.Stack)
+	}
+}
+
+func groupPanickingTask() error {
+	panic("task panic")
+}
+
+func TestGroup_SetLimit(t *testing.T) {
+	// Test that no more than the limit of tasks run at once
+	var g Group
+	g.SetLimit(2)
+
+	var running, peak atomic.Int32
+	for i := 0; i < 10; i++ {
+		g.Go(func() error {
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			running.Add(-1)
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if peak.Load() > 2 {
+		t.Errorf("Expected at most 2 concurrent tasks, got %d", peak.Load())
+	}
+}
+
+func TestGroup_WithContext(t *testing.T) {
+	// Test that the first failure cancels the group's context
+	t.Run("cancel_on_error", func(t *testing.T) {
+		testErr := errors.New("test error")
+		g, ctx := GroupWithContext(context.Background())
+
+		g.Go(func() error {
+			<-ctx.Done()
+			return nil
+		})
+		g.Go(func() error { return testErr })
+
+		if err := g.Wait(); !errors.Is(err, testErr) {
+			t.Errorf("Expected %v, got %v", testErr, err)
+		}
+		if cause := context.Cause(ctx); cause != testErr {
+			t.Errorf("Expected context cause %v, got %v", testErr, cause)
+		}
+	})
+
+	t.Run("cancel_on_panic", func(t *testing.T) {
+		g, ctx := GroupWithContext(context.Background())
+
+		g.Go(func() error {
+			<-ctx.Done()
+			return nil
+		})
+		g.Go(func() error { panic("boom") })
+
+		g.Wait()
+
+		var pe *PanicError
+		if !errors.As(context.Cause(ctx), &pe) {
+			t.Errorf("Expected context cause to be *PanicError, got %v", context.Cause(ctx))
+		}
+	})
+
+	t.Run("cancel_on_wait", func(t *testing.T) {
+		g, ctx := GroupWithContext(context.Background())
+		g.Go(func() error { return nil })
+
+		if err := g.Wait(); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if ctx.Err() == nil {
+			t.Error("Expected context to be cancelled after Wait")
+		}
+	})
+}
+
+// Benchmark tests
+func BenchmarkGroup_Go(b *testing.B) {
+	f := func() error { return nil }
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var g Group
+		g.Go(f)
+		g.Wait()
+	}
+}
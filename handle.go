@@ -101,3 +101,68 @@ func Handle[T any](h Handler[T]) func(T, error) T {
 		return v
 	}
 }
+
+// Recoverer is a richer form of Handler that also decides which value is
+// returned once an error has been handled. It receives the value and error
+// produced by the wrapped call and returns either a replacement value with a
+// nil error (the error was handled) or a non-nil error (which causes a panic).
+//
+// This matters because most functions returning (T, error) make no promise
+// about T when the error is non-nil, so returning it as Handle does is rarely
+// what you want.
+//
+// Example usage:
+//
+//	// Fall back to the last known good configuration
+//	useCached := func(cfg Config, err error) (Config, error) {
+//		if errors.Is(err, fs.ErrNotExist) {
+//			return cachedConfig, nil
+//		}
+//		return cfg, err
+//	}
+type Recoverer[T any] func(v T, err error) (T, error)
+
+// HandleWith is like Handle but uses a Recoverer, which lets the error path
+// supply a replacement value such as a default, a cached copy or Zero[T]().
+//
+// The returned function takes a value of type T and an error. If the error is
+// nil, the value is returned unchanged and r is not called. Otherwise r is
+// called with both:
+//   - If r returns a nil error, the value returned by r is returned
+//   - If r returns a non-nil error, that error is panicked with
+//
+// Example usage:
+//
+//	loadPort := HandleWith(func(port int, err error) (int, error) {
+//		log.Printf("invalid PORT, using default: %v", err)
+//		return 8080, nil
+//	})
+//	port := loadPort(strconv.Atoi(os.Getenv("PORT")))
+func HandleWith[T any](r Recoverer[T]) func(T, error) T {
+	return func(v T, err error) T {
+		if err != nil {
+			v, err = r(v, err)
+			if err != nil {
+				panic(err)
+			}
+		}
+		return v
+	}
+}
+
+// Fallback adapts a Handler into a Recoverer that returns def whenever h
+// handles the error, instead of the value produced by the failed call.
+//
+// Example usage:
+//
+//	// Missing files yield an empty document rather than a partial read
+//	read := HandleWith(Fallback(IgnoreIs[[]byte](fs.ErrNotExist), []byte("{}")))
+//	data := read(os.ReadFile(path))
+func Fallback[T any](h Handler[T], def T) Recoverer[T] {
+	return func(v T, err error) (T, error) {
+		if err = h(err); err != nil {
+			return v, err
+		}
+		return def, nil
+	}
+}
@@ -0,0 +1,169 @@
+package sugar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// Chain combines several handlers into one that runs them in order as a
+// pipeline. Each handler receives the error returned by the previous one; as
+// soon as a handler returns nil the error is considered handled and the
+// remaining handlers are skipped. With no handlers, Chain returns every error
+// unchanged.
+//
+// Example usage:
+//
+//	h := Handle[[]byte](Chain(
+//	    IgnoreIs[[]byte](fs.ErrNotExist),  // missing file is fine
+//	    Wrapf[[]byte]("reading %s", path),  // add context to the rest
+//	))
+//	data := h(os.ReadFile(path))
+func Chain[T any](hs ...Handler[T]) Handler[T] {
+	return func(err error) error {
+		for _, h := range hs {
+			if err = h(err); err == nil {
+				return nil
+			}
+		}
+		return err
+	}
+}
+
+// IgnoreIs returns a handler that swallows errors matching any of targets
+// according to errors.Is, and returns every other error unchanged.
+//
+// Example usage:
+//
+//	h := Handle[int](IgnoreIs[int](io.EOF, io.ErrUnexpectedEOF))
+func IgnoreIs[T any](targets ...error) Handler[T] {
+	return func(err error) error {
+		for _, target := range targets {
+			if errors.Is(err, target) {
+				return nil
+			}
+		}
+		return err
+	}
+}
+
+// IgnoreAs returns a handler that swallows errors for which errors.As finds
+// an error of type E in the chain, and returns every other error unchanged.
+//
+// Example usage:
+//
+//	h := Handle[[]byte](IgnoreAs[[]byte, *fs.PathError]())
+func IgnoreAs[T any, E error]() Handler[T] {
+	return func(err error) error {
+		var target E
+		if errors.As(err, &target) {
+			return nil
+		}
+		return err
+	}
+}
+
+// Wrapf returns a handler that adds context to every error it receives. The
+// error is formatted with format and args followed by ": " and the original
+// error, which stays reachable through errors.Is and errors.As.
+//
+// Example usage:
+//
+//	h := Handle[Config](Wrapf[Config]("loading config %q", path))
+//	// panics with: loading config "app.json": <original error>
+func Wrapf[T any](format string, args ...any) Handler[T] {
+	return func(err error) error {
+		return fmt.Errorf("%s: %w", fmt.Sprintf(format, args...), err)
+	}
+}
+
+// MapErr returns a handler that replaces errors matching from according to
+// errors.Is with to, and returns every other error unchanged.
+//
+// Example usage:
+//
+//	h := Handle[User](MapErr[User](sql.ErrNoRows, ErrUserNotFound))
+func MapErr[T any](from, to error) Handler[T] {
+	return func(err error) error {
+		if errors.Is(err, from) {
+			return to
+		}
+		return err
+	}
+}
+
+// LogHandler returns a handler that logs every error it receives with logger
+// at level, and returns the error unchanged so it can be combined with other
+// handlers through Chain. The record's source location is the code that
+// called into sugar (for example the call to the function returned by
+// Handle), not sugar itself. If the error holds a *PanicError, its value,
+// type and stack are logged as the "panic" group.
+//
+// Example usage:
+//
+//	h := Handle[Config](Chain(
+//	    LogHandler[Config](logger, slog.LevelWarn, "loading config"),
+//	    Wrapf[Config]("loading config %q", path),
+//	))
+//	cfg := h(loadConfig(path)) // logged with this line as its source
+func LogHandler[T any](logger *slog.Logger, level slog.Level, msg string) Handler[T] {
+	return func(err error) error {
+		ctx := context.Background()
+		if !logger.Enabled(ctx, level) {
+			return err
+		}
+
+		r := slog.NewRecord(time.Now(), level, msg, callerPC())
+		r.AddAttrs(slog.String("error", err.Error()))
+		var pe *PanicError
+		if errors.As(err, &pe) {
+			r.AddAttrs(slog.Any("panic", pe))
+		}
+		logger.Handler().Handle(ctx, r)
+		return err
+	}
+}
+
+// sugarPackage is the import path of this package, used by callerPC to
+// recognise its own frames.
+var sugarPackage = reflect.TypeOf(PanicError{}).PkgPath()
+
+// callerPC returns the program counter of the first caller outside this
+// package, skipping the frames of sugar's own handlers and adapters. Frames
+// from this package's tests count as callers.
+func callerPC() uintptr {
+	var pcs [32]uintptr
+	n := runtime.Callers(2, pcs[:])
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		internal := funcPackage(frame.Function) == sugarPackage &&
+			!strings.HasSuffix(frame.File, "_test.go")
+		if !internal || !more {
+			// Frame.PC points at the call instruction, while slog expects a
+			// return address as reported by runtime.Callers.
+			return frame.PC + 1
+		}
+	}
+}
+
+// funcPackage returns the import path of the package defining the function
+// with the given runtime name, for example "example.com/sugar.v2" for
+// "example.com/sugar%2ev2.Handle[...].func1". The runtime escapes dots in the
+// last element of the path, and escapes them twice in the names of generic
+// function instantiations.
+func funcPackage(name string) string {
+	slash := strings.LastIndex(name, "/")
+	dot := strings.Index(name[slash+1:], ".")
+	if dot < 0 {
+		return ""
+	}
+	pkg := name[:slash+1+dot]
+	pkg = strings.ReplaceAll(pkg, "%25", "%")
+	return strings.ReplaceAll(pkg, "%2e", ".")
+}
@@ -0,0 +1,257 @@
+package sugar
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestChain(t *testing.T) {
+	// Test that handlers run as a pipeline and stop at the first nil
+	t.Run("pipeline", func(t *testing.T) {
+		var seen []error
+		record := func(err error) error {
+			seen = append(seen, err)
+			return err
+		}
+		wrapped := errors.New("wrapped")
+
+		h := Chain[int](
+			record,
+			func(err error) error { return wrapped },
+			record,
+		)
+
+		if err := h(io.EOF); err != wrapped {
+			t.Errorf("Expected %v, got %v", wrapped, err)
+		}
+		if len(seen) != 2 || seen[0] != io.EOF || seen[1] != wrapped {
+			t.Errorf("Expected handlers to see [EOF wrapped], got %v", seen)
+		}
+	})
+
+	t.Run("short_circuit", func(t *testing.T) {
+		called := false
+		h := Chain[int](
+			IgnoreIs[int](io.EOF),
+			func(err error) error {
+				called = true
+				return err
+			},
+		)
+
+		if err := h(io.EOF); err != nil {
+			t.Errorf("Expected nil, got %v", err)
+		}
+		if called {
+			t.Error("Expected later handlers to be skipped")
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		h := Chain[int]()
+		if err := h(io.EOF); err != io.EOF {
+			t.Errorf("Expected %v, got %v", io.EOF, err)
+		}
+	})
+}
+
+func TestIgnoreIs(t *testing.T) {
+	// Test that only matching errors are swallowed
+	h := IgnoreIs[string](io.EOF, fs.ErrNotExist)
+
+	if err := h(io.EOF); err != nil {
+		t.Errorf("Expected nil for io.EOF, got %v", err)
+	}
+	if err := h(&fs.PathError{Op: "open", Path: "x", Err: fs.ErrNotExist}); err != nil {
+		t.Errorf("Expected nil for wrapped fs.ErrNotExist, got %v", err)
+	}
+	if err := h(io.ErrClosedPipe); err != io.ErrClosedPipe {
+		t.Errorf("Expected %v, got %v", io.ErrClosedPipe, err)
+	}
+}
+
+func TestIgnoreAs(t *testing.T) {
+	// Test that only errors of the given type are swallowed
+	h := IgnoreAs[string, *fs.PathError]()
+
+	if err := h(&fs.PathError{Op: "open", Path: "x", Err: fs.ErrNotExist}); err != nil {
+		t.Errorf("Expected nil for *fs.PathError, got %v", err)
+	}
+	if err := h(io.EOF); err != io.EOF {
+		t.Errorf("Expected %v, got %v", io.EOF, err)
+	}
+}
+
+func TestWrapf(t *testing.T) {
+	// Test that errors are wrapped with formatted context
+	h := Wrapf[int]("reading %s", "config.json")
+
+	err := h(io.EOF)
+	expected := "reading config.json: EOF"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error message %q, got %v", expected, err)
+	}
+	if !errors.Is(err, io.EOF) {
+		t.Error("Expected wrapped error to match io.EOF")
+	}
+}
+
+func TestMapErr(t *testing.T) {
+	// Test that matching errors are replaced and others pass through
+	errNotFound := errors.New("not found")
+	h := MapErr[int](fs.ErrNotExist, errNotFound)
+
+	if err := h(&fs.PathError{Op: "open", Path: "x", Err: fs.ErrNotExist}); err != errNotFound {
+		t.Errorf("Expected %v, got %v", errNotFound, err)
+	}
+	if err := h(io.EOF); err != io.EOF {
+		t.Errorf("Expected %v, got %v", io.EOF, err)
+	}
+}
+
+func TestHandlers_WithHandle(t *testing.T) {
+	// Test that the combinators plug directly into Handle
+	handler := Handle[int](Chain(
+		IgnoreIs[int](io.EOF),
+		Wrapf[int]("step failed"),
+	))
+
+	if result := handler(42, io.EOF); result != 42 {
+		t.Errorf("Expected 42, got %d", result)
+	}
+
+	defer func() {
+		err, ok := recover().(error)
+		if !ok || !errors.Is(err, io.ErrClosedPipe) {
+			t.Errorf("Expected panic wrapping %v, got %v", io.ErrClosedPipe, err)
+		}
+	}()
+
+	handler(0, io.ErrClosedPipe)
+	t.Error("Expected function to panic, but it didn't")
+}
+
+func newLogHandlerLogger(level slog.Level) (*slog.Logger, *bytes.Buffer) {
+	var buf bytes.Buffer
+	opts := &slog.HandlerOptions{AddSource: true, Level: level}
+	return slog.New(slog.NewJSONHandler(&buf, opts)), &buf
+}
+
+func TestLogHandler(t *testing.T) {
+	// Test that errors are logged with the caller's source location and returned unchanged
+	logger, buf := newLogHandlerLogger(slog.LevelInfo)
+	handler := Handle[int](Chain(
+		LogHandler[int](logger, slog.LevelWarn, "step failed"),
+		IgnoreIs[int](io.EOF),
+	))
+
+	_, _, line, _ := runtime.Caller(0)
+	if result := handler(42, io.EOF); result != 42 {
+		t.Errorf("Expected 42, got %d", result)
+	}
+
+	var entry struct {
+		Level  string
+		Msg    string
+		Error  string
+		Source struct {
+			File string
+			Line int
+		}
+	}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("Expected one JSON log entry, got %q: %v", buf.String(), err)
+	}
+	if entry.Level != "WARN" || entry.Msg != "step failed" || entry.Error != io.EOF.Error() {
+		t.Errorf("Expected WARN %q with error %q, got %+v", "step failed", io.EOF, entry)
+	}
+	if !strings.HasSuffix(entry.Source.File, "handlers_test.go") || entry.Source.Line != line+1 {
+		t.Errorf("Expected source handlers_test.go:%d, got %s:%d", line+1, entry.Source.File, entry.Source.Line)
+	}
+}
+
+func TestLogHandler_ReturnsError(t *testing.T) {
+	// Test that the handler passes the error through unchanged
+	logger, _ := newLogHandlerLogger(slog.LevelInfo)
+	h := LogHandler[int](logger, slog.LevelError, "failed")
+
+	if err := h(io.ErrClosedPipe); err != io.ErrClosedPipe {
+		t.Errorf("Expected %v, got %v", io.ErrClosedPipe, err)
+	}
+}
+
+func TestLogHandler_Disabled(t *testing.T) {
+	// Test that nothing is logged below the logger's level
+	logger, buf := newLogHandlerLogger(slog.LevelError)
+	h := LogHandler[int](logger, slog.LevelDebug, "failed")
+
+	if err := h(io.EOF); err != io.EOF {
+		t.Errorf("Expected %v, got %v", io.EOF, err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Expected nothing logged, got %s", buf)
+	}
+}
+
+func TestLogHandler_PanicError(t *testing.T) {
+	// Test that a recovered panic is logged with its value, type and stack
+	logger, buf := newLogHandlerLogger(slog.LevelInfo)
+	h := LogHandler[int](logger, slog.LevelError, "recovered")
+
+	_, err := Try(func() int {
+		var m map[string]int
+		m["key"] = 1
+		return 0
+	})
+	h(fmt.Errorf("worker: %w", err))
+
+	var entry struct {
+		Panic struct {
+			Value string
+			Type  string
+			Stack string
+		}
+	}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("Expected one JSON log entry, got %q: %v", buf.String(), err)
+	}
+	if !strings.Contains(entry.Panic.Value, "nil map") {
+		t.Errorf("Expected panic value in log, got %q", entry.Panic.Value)
+	}
+	if entry.Panic.Type != "runtime.plainError" {
+		t.Errorf("Expected panic type %q, got %q", "runtime.plainError", entry.Panic.Type)
+	}
+	if !strings.Contains(entry.Panic.Stack, "TestLogHandler_PanicError") {
+		t.Errorf("Expected stack in log, got %q", entry.Panic.Stack)
+	}
+}
+
+func TestFuncPackage(t *testing.T) {
+	// Test that package paths are recovered from escaped runtime function names
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"github.com/dccarswell/sugar.LogHandler[...].func1", "github.com/dccarswell/sugar"},
+		{"sugar.Handle[...].func1", "sugar"},
+		{"example.com/sugar%2ev2.Chain[...].func1", "example.com/sugar.v2"},
+		{"example.com/sugar%252ev2.Handle[...].func1", "example.com/sugar.v2"},
+		{"example.com/sugar.v2/sugarhttp.Handle", "example.com/sugar.v2/sugarhttp"},
+		{"main.main", "main"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := funcPackage(tt.name); got != tt.expected {
+			t.Errorf("Expected funcPackage(%q) to be %q, got %q", tt.name, tt.expected, got)
+		}
+	}
+}
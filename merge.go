@@ -0,0 +1,115 @@
+package sugar
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Merge copies every set field of patch into dst, where a field is set if it
+// is a non-nil pointer or any other non-zero value. It is meant for layered
+// configuration (defaults, then file, then environment, then flags) and for
+// PATCH endpoints, where structs use optional pointer fields such as
+// Timeout *int to tell "unset" apart from "set to the zero value".
+//
+// Fields are merged as follows:
+//   - Nested struct fields are merged recursively, field by field
+//   - Pointers to structs are merged recursively when both sides are non-nil;
+//     the result is a new struct, so the value dst pointed to is not modified
+//   - Every other field, including slices and maps, is replaced as a whole
+//   - Unexported fields are ignored, and structs with unexported fields (such
+//     as time.Time) are treated as a single value rather than recursed into
+//
+// The behaviour of a field can be changed with a `merge` struct tag:
+//   - `merge:"-"` never copies the field from the patch
+//   - `merge:"overwrite"` always copies the field, even when it is zero, which
+//     allows a layer to reset a value
+//
+// Merge returns an error if dst is nil or T is not a struct type.
+//
+// Example usage:
+//
+//	type Config struct {
+//	    Host    string
+//	    Port    int
+//	    Timeout *int
+//	    Debug   *bool
+//	    Secret  string `merge:"-"`
+//	}
+//	cfg := Config{Host: "localhost", Port: 8080, Timeout: Ptr(30)}
+//	Must0(Merge(&cfg, Config{Port: 9090, Debug: Ptr(false)}))
+//	// cfg is {Host: "localhost", Port: 9090, Timeout: 30, Debug: false}
+func Merge[T any](dst *T, patch T) error {
+	if dst == nil {
+		return errors.New("sugar: Merge called with nil destination")
+	}
+	d := reflect.ValueOf(dst).Elem()
+	if d.Kind() != reflect.Struct {
+		return fmt.Errorf("sugar: Merge requires a struct type, got %s", d.Type())
+	}
+	mergeStruct(d, reflect.ValueOf(patch))
+	return nil
+}
+
+// MergeAll merges each patch into dst in order, so later patches take
+// precedence over earlier ones.
+//
+// Example usage:
+//
+//	cfg := defaults
+//	Must0(MergeAll(&cfg, fileConfig, envConfig, flagConfig))
+func MergeAll[T any](dst *T, patches ...T) error {
+	for _, patch := range patches {
+		if err := Merge(dst, patch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func mergeStruct(dst, patch reflect.Value) {
+	t := dst.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		d, p := dst.Field(i), patch.Field(i)
+
+		switch field.Tag.Get("merge") {
+		case "-":
+			continue
+		case "overwrite":
+			d.Set(p)
+			continue
+		}
+		mergeValue(d, p)
+	}
+}
+
+func mergeValue(dst, patch reflect.Value) {
+	switch {
+	case patch.Kind() == reflect.Struct && allFieldsExported(patch.Type()):
+		mergeStruct(dst, patch)
+	case patch.Kind() == reflect.Pointer && !patch.IsNil() && !dst.IsNil() &&
+		patch.Elem().Kind() == reflect.Struct && allFieldsExported(patch.Elem().Type()):
+		merged := reflect.New(dst.Elem().Type())
+		merged.Elem().Set(dst.Elem())
+		mergeStruct(merged.Elem(), patch.Elem())
+		dst.Set(merged)
+	case !patch.IsZero():
+		dst.Set(patch)
+	}
+}
+
+// allFieldsExported reports whether every field of struct type t is exported.
+// Structs that fail this check, such as time.Time, are treated as opaque values
+// rather than walked field by field.
+func allFieldsExported(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if !t.Field(i).IsExported() {
+			return false
+		}
+	}
+	return true
+}
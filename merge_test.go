@@ -0,0 +1,189 @@
+package sugar
+
+import (
+	"testing"
+	"time"
+)
+
+type mergeDatabase struct {
+	Host     string
+	Port     int
+	MaxConns *int
+}
+
+type mergeConfig struct {
+	Host     string
+	Port     int
+	Timeout  *int
+	Debug    *bool
+	Tags     []string
+	Started  time.Time
+	Database mergeDatabase
+	Cache    *mergeDatabase
+	Secret   string `merge:"-"`
+	Level    int    `merge:"overwrite"`
+	internal int
+}
+
+func TestMerge_BasicFields(t *testing.T) {
+	// Test that set fields are copied and unset fields are kept
+	dst := mergeConfig{Host: "localhost", Port: 8080, Timeout: Ptr(30)}
+	patch := mergeConfig{Port: 9090, Debug: Ptr(false)}
+
+	if err := Merge(&dst, patch); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if dst.Host != "localhost" {
+		t.Errorf("Expected Host to be kept, got %q", dst.Host)
+	}
+	if dst.Port != 9090 {
+		t.Errorf("Expected Port 9090, got %d", dst.Port)
+	}
+	if dst.Timeout == nil || *dst.Timeout != 30 {
+		t.Errorf("Expected Timeout to be kept, got %v", dst.Timeout)
+	}
+	if dst.Debug == nil || *dst.Debug != false {
+		t.Errorf("Expected Debug to be set to false, got %v", dst.Debug)
+	}
+}
+
+func TestMerge_ReplacedFields(t *testing.T) {
+	// Test that slices and opaque structs are replaced as a whole
+	started := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	dst := mergeConfig{Tags: []string{"a", "b"}}
+	patch := mergeConfig{Tags: []string{"c"}, Started: started}
+
+	if err := Merge(&dst, patch); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(dst.Tags) != 1 || dst.Tags[0] != "c" {
+		t.Errorf("Expected Tags [c], got %v", dst.Tags)
+	}
+	if !dst.Started.Equal(started) {
+		t.Errorf("Expected Started %v, got %v", started, dst.Started)
+	}
+}
+
+func TestMerge_NestedStructs(t *testing.T) {
+	// Test that nested structs and struct pointers are merged recursively
+	t.Run("struct_value", func(t *testing.T) {
+		dst := mergeConfig{Database: mergeDatabase{Host: "db", Port: 5432}}
+		patch := mergeConfig{Database: mergeDatabase{MaxConns: Ptr(10)}}
+
+		if err := Merge(&dst, patch); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if dst.Database.Host != "db" || dst.Database.Port != 5432 {
+			t.Errorf("Expected nested fields to be kept, got %+v", dst.Database)
+		}
+		if Deref(dst.Database.MaxConns) != 10 {
+			t.Errorf("Expected MaxConns 10, got %v", dst.Database.MaxConns)
+		}
+	})
+
+	t.Run("struct_pointer", func(t *testing.T) {
+		original := &mergeDatabase{Host: "cache", Port: 6379}
+		dst := mergeConfig{Cache: original}
+		patch := mergeConfig{Cache: &mergeDatabase{Port: 6380}}
+
+		if err := Merge(&dst, patch); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if dst.Cache.Host != "cache" || dst.Cache.Port != 6380 {
+			t.Errorf("Expected {cache 6380}, got %+v", *dst.Cache)
+		}
+		if original.Port != 6379 {
+			t.Errorf("Expected original struct to be unmodified, got %+v", *original)
+		}
+	})
+
+	t.Run("nil_struct_pointer", func(t *testing.T) {
+		dst := mergeConfig{}
+		patch := mergeConfig{Cache: &mergeDatabase{Host: "cache"}}
+
+		if err := Merge(&dst, patch); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if dst.Cache == nil || dst.Cache.Host != "cache" {
+			t.Errorf("Expected Cache to be set, got %v", dst.Cache)
+		}
+	})
+}
+
+func TestMerge_Tags(t *testing.T) {
+	// Test the merge struct tag options
+	dst := mergeConfig{Secret: "keep", Level: 3}
+	patch := mergeConfig{Secret: "replace", Level: 0}
+
+	if err := Merge(&dst, patch); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if dst.Secret != "keep" {
+		t.Errorf("Expected Secret to be skipped, got %q", dst.Secret)
+	}
+	if dst.Level != 0 {
+		t.Errorf("Expected Level to be overwritten with zero, got %d", dst.Level)
+	}
+}
+
+func TestMerge_UnexportedFields(t *testing.T) {
+	// Test that unexported fields are left alone
+	dst := mergeConfig{internal: 1}
+	patch := mergeConfig{internal: 2}
+
+	if err := Merge(&dst, patch); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if dst.internal != 1 {
+		t.Errorf("Expected unexported field to be kept, got %d", dst.internal)
+	}
+}
+
+func TestMerge_Errors(t *testing.T) {
+	// Test invalid arguments
+	t.Run("nil_destination", func(t *testing.T) {
+		if err := Merge(nil, mergeConfig{}); err == nil {
+			t.Error("Expected error for nil destination")
+		}
+	})
+
+	t.Run("non_struct", func(t *testing.T) {
+		dst := 1
+		if err := Merge(&dst, 2); err == nil {
+			t.Error("Expected error for non-struct type")
+		}
+	})
+}
+
+func TestMergeAll(t *testing.T) {
+	// Test that later patches take precedence over earlier ones
+	defaults := mergeConfig{Host: "localhost", Port: 8080, Timeout: Ptr(30)}
+	file := mergeConfig{Host: "example.com", Timeout: Ptr(60)}
+	env := mergeConfig{Port: 9090}
+	flags := mergeConfig{Timeout: Ptr(5)}
+
+	cfg := defaults
+	if err := MergeAll(&cfg, file, env, flags); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if cfg.Host != "example.com" || cfg.Port != 9090 || Deref(cfg.Timeout) != 5 {
+		t.Errorf("Expected {example.com 9090 5}, got {%s %d %d}", cfg.Host, cfg.Port, Deref(cfg.Timeout))
+	}
+	if Deref(defaults.Timeout) != 30 {
+		t.Errorf("Expected defaults to be unmodified, got %d", Deref(defaults.Timeout))
+	}
+}
+
+// Benchmark tests
+func BenchmarkMerge(b *testing.B) {
+	patch := mergeConfig{Port: 9090, Debug: Ptr(true)}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst := mergeConfig{Host: "localhost"}
+		Merge(&dst, patch)
+	}
+}
@@ -80,3 +80,38 @@ func Must[T any](v T, err error) T {
 	}
 	return v
 }
+
+// Must0 is the zero-value variant of Must for functions that only return an
+// error, such as Close or Flush. It panics with err if err is non-nil.
+//
+// Example usage:
+//
+//	Must0(f.Close())
+//	Must0(json.Unmarshal(data, &cfg))
+func Must0(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Must2 is the two-value variant of Must. It returns v1 and v2 if err is nil,
+// and panics with err otherwise.
+//
+// Example usage:
+//
+//	host, port := Must2(net.SplitHostPort("localhost:8080"))
+func Must2[T1, T2 any](v1 T1, v2 T2, err error) (T1, T2) {
+	if err != nil {
+		panic(err)
+	}
+	return v1, v2
+}
+
+// Must3 is the three-value variant of Must. It returns v1, v2 and v3 if err is
+// nil, and panics with err otherwise.
+func Must3[T1, T2, T3 any](v1 T1, v2 T2, v3 T3, err error) (T1, T2, T3) {
+	if err != nil {
+		panic(err)
+	}
+	return v1, v2, v3
+}
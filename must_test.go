@@ -248,6 +248,62 @@ func TestMust_Interface(t *testing.T) {
 	})
 }
 
+func TestMust_Arities(t *testing.T) {
+	// Test the multi-value Must variants
+	testErr := errors.New("test error")
+
+	t.Run("must0_no_error", func(t *testing.T) {
+		Must0(nil)
+	})
+
+	t.Run("must0_with_error", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r != testErr {
+				t.Errorf("Expected panic with %v, got %v", testErr, r)
+			}
+		}()
+
+		Must0(testErr)
+		t.Error("Expected function to panic, but it didn't")
+	})
+
+	t.Run("must2_no_error", func(t *testing.T) {
+		host, port := Must2("localhost", 8080, nil)
+		if host != "localhost" || port != 8080 {
+			t.Errorf("Expected (localhost, 8080), got (%s, %d)", host, port)
+		}
+	})
+
+	t.Run("must2_with_error", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r != testErr {
+				t.Errorf("Expected panic with %v, got %v", testErr, r)
+			}
+		}()
+
+		Must2("localhost", 8080, testErr)
+		t.Error("Expected function to panic, but it didn't")
+	})
+
+	t.Run("must3_no_error", func(t *testing.T) {
+		a, b, c := Must3(1, "two", 3.0, nil)
+		if a != 1 || b != "two" || c != 3.0 {
+			t.Errorf("Expected (1, two, 3), got (%d, %s, %v)", a, b, c)
+		}
+	})
+
+	t.Run("must3_with_error", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r != testErr {
+				t.Errorf("Expected panic with %v, got %v", testErr, r)
+			}
+		}()
+
+		Must3(1, "two", 3.0, testErr)
+		t.Error("Expected function to panic, but it didn't")
+	})
+}
+
 // Benchmark tests
 func BenchmarkMust_NoError(b *testing.B) {
 	b.ResetTimer()
@@ -0,0 +1,132 @@
+package sugar
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// Option holds either a value of type T or nothing. It expresses an optional
+// field without the shared, mutable aliasing that comes with using a pointer
+// for the same purpose, and it converts to and from pointers with FromPtr and
+// ToPtr where an API still expects them.
+//
+// Option implements json.Marshaler and json.Unmarshaler (None is encoded as
+// null), and sql.Scanner and driver.Valuer (None is stored as NULL), so it can
+// be used directly in API DTOs and database rows.
+//
+// The zero value is None, which also makes the `json:",omitzero"` tag option
+// omit absent fields.
+//
+// Example usage:
+//
+//	type UpdateUser struct {
+//	    Name Option[string] `json:"name,omitzero"`
+//	    Age  Option[int]    `json:"age,omitzero"`
+//	}
+//
+//	var req UpdateUser
+//	Must0(json.Unmarshal(body, &req))
+//	if name, ok := req.Name.Get(); ok {
+//	    user.Name = name
+//	}
+type Option[T any] struct {
+	v  T
+	ok bool
+}
+
+// Some returns an Option holding v.
+func Some[T any](v T) Option[T] {
+	return Option[T]{v: v, ok: true}
+}
+
+// None returns an empty Option.
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// FromPtr returns None if p is nil, and otherwise an Option holding a copy of
+// the value p points to.
+func FromPtr[T any](p *T) Option[T] {
+	if p == nil {
+		return None[T]()
+	}
+	return Some(*p)
+}
+
+// ToPtr returns nil if o is None, and otherwise a pointer to a copy of the
+// value held by o, created with Ptr.
+func (o Option[T]) ToPtr() *T {
+	if !o.ok {
+		return nil
+	}
+	return Ptr(o.v)
+}
+
+// Get returns the value held by o and true, or Zero[T]() and false if o is
+// None.
+func (o Option[T]) Get() (T, bool) {
+	return o.v, o.ok
+}
+
+// IsSome reports whether o holds a value.
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+// OrZero returns the value held by o, or Zero[T]() if o is None.
+func (o Option[T]) OrZero() T {
+	if !o.ok {
+		return Zero[T]()
+	}
+	return o.v
+}
+
+// Or returns the value held by o, or def if o is None.
+func (o Option[T]) Or(def T) T {
+	if !o.ok {
+		return def
+	}
+	return o.v
+}
+
+// MarshalJSON encodes None as null and Some(v) as the JSON encoding of v.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if !o.ok {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.v)
+}
+
+// UnmarshalJSON decodes null as None and anything else as Some of the
+// decoded value.
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = None[T]()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
+
+// Scan implements sql.Scanner. NULL is scanned as None; any other value is
+// converted to T with the same rules database/sql uses for Rows.Scan.
+func (o *Option[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+	*o = Option[T]{v: n.V, ok: n.Valid}
+	return nil
+}
+
+// Value implements driver.Valuer. None is stored as NULL; any other value is
+// converted with the same rules database/sql uses for query arguments.
+func (o Option[T]) Value() (driver.Value, error) {
+	return sql.Null[T]{V: o.v, Valid: o.ok}.Value()
+}
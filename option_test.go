@@ -0,0 +1,197 @@
+package sugar
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+var (
+	_ json.Marshaler   = Option[int]{}
+	_ json.Unmarshaler = (*Option[int])(nil)
+	_ sql.Scanner      = (*Option[int])(nil)
+	_ driver.Valuer    = Option[int]{}
+)
+
+func TestOption_Constructors(t *testing.T) {
+	// Test the ways of building an Option
+	t.Run("some", func(t *testing.T) {
+		v, ok := Some(42).Get()
+		if !ok || v != 42 {
+			t.Errorf("Expected (42, true), got (%d, %t)", v, ok)
+		}
+	})
+
+	t.Run("none", func(t *testing.T) {
+		v, ok := None[string]().Get()
+		if ok || v != "" {
+			t.Errorf("Expected (\"\", false), got (%q, %t)", v, ok)
+		}
+	})
+
+	t.Run("zero_value", func(t *testing.T) {
+		var o Option[int]
+		if o.IsSome() {
+			t.Error("Expected zero Option to be None")
+		}
+	})
+
+	t.Run("some_zero", func(t *testing.T) {
+		// Some of a zero value is still present
+		if !Some(0).IsSome() {
+			t.Error("Expected Some(0) to hold a value")
+		}
+	})
+}
+
+func TestOption_PtrInterop(t *testing.T) {
+	// Test conversion to and from pointers
+	t.Run("from_nil", func(t *testing.T) {
+		if FromPtr[int](nil).IsSome() {
+			t.Error("Expected FromPtr(nil) to be None")
+		}
+	})
+
+	t.Run("from_ptr_copies", func(t *testing.T) {
+		p := Ptr(42)
+		o := FromPtr(p)
+		*p = 100
+		if v, _ := o.Get(); v != 42 {
+			t.Errorf("Expected Option to hold a copy (42), got %d", v)
+		}
+	})
+
+	t.Run("to_ptr", func(t *testing.T) {
+		if None[int]().ToPtr() != nil {
+			t.Error("Expected None.ToPtr() to be nil")
+		}
+		p := Some("hello").ToPtr()
+		if p == nil || *p != "hello" {
+			t.Errorf("Expected pointer to hello, got %v", p)
+		}
+	})
+}
+
+func TestOption_Defaults(t *testing.T) {
+	// Test OrZero and Or
+	if v := None[int]().OrZero(); v != 0 {
+		t.Errorf("Expected 0, got %d", v)
+	}
+	if v := Some(42).OrZero(); v != 42 {
+		t.Errorf("Expected 42, got %d", v)
+	}
+	if v := None[string]().Or("default"); v != "default" {
+		t.Errorf("Expected default, got %q", v)
+	}
+	if v := Some("value").Or("default"); v != "value" {
+		t.Errorf("Expected value, got %q", v)
+	}
+}
+
+func TestOption_JSON(t *testing.T) {
+	// Test JSON encoding and decoding inside a struct
+	type DTO struct {
+		Name  Option[string] `json:"name"`
+		Age   Option[int]    `json:"age"`
+		Email Option[string] `json:"email,omitzero"`
+	}
+
+	t.Run("marshal", func(t *testing.T) {
+		data, err := json.Marshal(DTO{Name: Some("Alice")})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		expected := `{"name":"Alice","age":null}`
+		if string(data) != expected {
+			t.Errorf("Expected %s, got %s", expected, data)
+		}
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var dto DTO
+		if err := json.Unmarshal([]byte(`{"name":"Bob","age":null}`), &dto); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if v, ok := dto.Name.Get(); !ok || v != "Bob" {
+			t.Errorf("Expected name Some(Bob), got %+v", dto.Name)
+		}
+		if dto.Age.IsSome() {
+			t.Errorf("Expected age None for null, got %+v", dto.Age)
+		}
+		if dto.Email.IsSome() {
+			t.Errorf("Expected email None when absent, got %+v", dto.Email)
+		}
+	})
+
+	t.Run("unmarshal_error", func(t *testing.T) {
+		var o Option[int]
+		if err := json.Unmarshal([]byte(`"not a number"`), &o); err == nil {
+			t.Error("Expected error for mismatched type")
+		}
+	})
+}
+
+func TestOption_SQL(t *testing.T) {
+	// Test sql.Scanner and driver.Valuer
+	t.Run("scan_null", func(t *testing.T) {
+		o := Some(42)
+		if err := o.Scan(nil); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if o.IsSome() {
+			t.Error("Expected NULL to scan as None")
+		}
+	})
+
+	t.Run("scan_value", func(t *testing.T) {
+		var o Option[int]
+		if err := o.Scan(int64(42)); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if v, ok := o.Get(); !ok || v != 42 {
+			t.Errorf("Expected Some(42), got %+v", o)
+		}
+	})
+
+	t.Run("scan_time", func(t *testing.T) {
+		now := time.Now()
+		var o Option[time.Time]
+		if err := o.Scan(now); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if v, ok := o.Get(); !ok || !v.Equal(now) {
+			t.Errorf("Expected Some(%v), got %+v", now, o)
+		}
+	})
+
+	t.Run("scan_error", func(t *testing.T) {
+		var o Option[int]
+		if err := o.Scan("not a number"); err == nil {
+			t.Error("Expected error for unconvertible value")
+		}
+	})
+
+	t.Run("value", func(t *testing.T) {
+		v, err := None[string]().Value()
+		if err != nil || v != nil {
+			t.Errorf("Expected (nil, nil), got (%v, %v)", v, err)
+		}
+
+		v, err = Some(int32(42)).Value()
+		if err != nil || v != int64(42) {
+			t.Errorf("Expected (int64(42), nil), got (%v (%T), %v)", v, v, err)
+		}
+	})
+}
+
+// Benchmark tests
+func BenchmarkOption_MarshalJSON(b *testing.B) {
+	o := Some(42)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		o.MarshalJSON()
+	}
+}
@@ -0,0 +1,152 @@
+package sugar
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime"
+	"runtime/debug"
+	"strings"
+)
+
+// Sentinel errors classifying the runtime errors a *PanicError can hold. They
+// let callers route panics by category with errors.Is instead of matching on
+// message substrings:
+//
+//	_, err := Try(f)
+//	switch {
+//	case errors.Is(err, ErrNilDereference):
+//	    // ...
+//	case errors.Is(err, ErrIndexOutOfRange):
+//	    // ...
+//	}
+//
+// Panics that are not runtime errors, such as errors thrown through Must, match
+// none of them.
+var (
+	// ErrNilDereference classifies nil pointer dereferences.
+	ErrNilDereference = errors.New("sugar: nil pointer dereference")
+
+	// ErrIndexOutOfRange classifies out-of-range index and slice expressions.
+	ErrIndexOutOfRange = errors.New("sugar: index out of range")
+
+	// ErrDivideByZero classifies integer division by zero.
+	ErrDivideByZero = errors.New("sugar: integer divide by zero")
+
+	// ErrTypeAssertion classifies failed type assertions and conversions.
+	ErrTypeAssertion = errors.New("sugar: failed type assertion")
+
+	// ErrClosedChannel classifies sends on or closes of a closed channel, and
+	// closes of a nil channel.
+	ErrClosedChannel = errors.New("sugar: invalid operation on closed channel")
+
+	// ErrMapWrite classifies assignments to entries of a nil map.
+	ErrMapWrite = errors.New("sugar: assignment to entry in nil map")
+)
+
+// PanicError is the error returned by Try when the wrapped function panics.
+// Unlike a flattened error string, it keeps the raw value passed to panic and
+// the stack of the panicking goroutine, so callers can still tell what went
+// wrong and where.
+//
+// If the panic value is itself an error (for example an error thrown through
+// Must), PanicError unwraps to it, which makes errors.Is and errors.As see
+// through the panic:
+//
+//	_, err := Try(func() []byte {
+//	    return Must(io.ReadAll(r))
+//	})
+//	if errors.Is(err, io.ErrUnexpectedEOF) {
+//	    // the error passed to Must
+//	}
+//
+//	var pe *PanicError
+//	if errors.As(err, &pe) {
+//	    log.Printf("recovered %T: %v\n%s", pe.Value, pe.Value, pe.Stack)
+//	}
+type PanicError struct {
+	// Value is the value passed to panic, exactly as returned by recover.
+	Value any
+
+	// Stack is the stack trace of the panicking goroutine, captured at the
+	// point of recovery in the format produced by runtime/debug.Stack.
+	Stack []byte
+}
+
+// newPanicError builds a PanicError for a recovered value. It must be called
+// from the deferred function that recovered the panic so that the captured
+// stack still contains the frames that panicked.
+func newPanicError(r any) *PanicError {
+	return &PanicError{Value: r, Stack: debug.Stack()}
+}
+
+// Error formats the panic value as "panic: <value>".
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Is reports whether target is the sentinel error classifying the runtime
+// error held by e, such as ErrNilDereference.
+func (e *PanicError) Is(target error) bool {
+	class := classifyPanic(e.Value)
+	return class != nil && class == target
+}
+
+// Unwrap returns the panic value if it is an error, and nil otherwise.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// LogValue implements slog.LogValuer. Logged under a key such as "panic", a
+// PanicError becomes a group holding the panic value, its dynamic type and
+// the stack, instead of the single "panic: ..." string given by Error:
+//
+//	logger.Error("request failed", "panic", pe)
+//	// panic.value=... panic.type=runtime.boundsError panic.stack=...
+func (e *PanicError) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Any("value", e.Value),
+		slog.String("type", fmt.Sprintf("%T", e.Value)),
+		slog.String("stack", string(e.Stack)),
+	)
+}
+
+// runtimeErrorClasses maps fragments of runtime error messages to the
+// sentinel classifying them. The runtime does not export types for most of
+// these errors, so the message is the only stable way to tell them apart.
+var runtimeErrorClasses = []struct {
+	fragment string
+	class    error
+}{
+	{"nil pointer dereference", ErrNilDereference},
+	{"index out of range", ErrIndexOutOfRange},
+	{"slice bounds out of range", ErrIndexOutOfRange},
+	{"integer divide by zero", ErrDivideByZero},
+	{"send on closed channel", ErrClosedChannel},
+	{"close of closed channel", ErrClosedChannel},
+	{"close of nil channel", ErrClosedChannel},
+	{"assignment to entry in nil map", ErrMapWrite},
+}
+
+// classifyPanic returns the sentinel error classifying the recovered value r,
+// or nil if r is not a recognised runtime error.
+func classifyPanic(r any) error {
+	re, ok := r.(runtime.Error)
+	if !ok {
+		return nil
+	}
+	var tae *runtime.TypeAssertionError
+	if errors.As(re, &tae) {
+		return ErrTypeAssertion
+	}
+	msg := re.Error()
+	for _, c := range runtimeErrorClasses {
+		if strings.Contains(msg, c.fragment) {
+			return c.class
+		}
+	}
+	return nil
+}
@@ -0,0 +1,232 @@
+package sugar
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+type testCodeError struct {
+	Code int
+}
+
+func (e *testCodeError) Error() string { return "code error" }
+
+func TestPanicError_FromTry(t *testing.T) {
+	// Test that Try returns a *PanicError carrying the raw panic value
+	t.Run("string_value", func(t *testing.T) {
+		_, err := Try(func() int {
+			panic("boom")
+		})
+
+		var pe *PanicError
+		if !errors.As(err, &pe) {
+			t.Fatalf("Expected *PanicError, got %T", err)
+		}
+		if pe.Value != "boom" {
+			t.Errorf("Expected panic value %q, got %v", "boom", pe.Value)
+		}
+	})
+
+	t.Run("int_value", func(t *testing.T) {
+		_, err := Try(func() string {
+			panic(123)
+		})
+
+		var pe *PanicError
+		if !errors.As(err, &pe) {
+			t.Fatalf("Expected *PanicError, got %T", err)
+		}
+		if v, ok := pe.Value.(int); !ok || v != 123 {
+			t.Errorf("Expected panic value 123 of type int, got %v (%T)", pe.Value, pe.Value)
+		}
+	})
+}
+
+func TestPanicError_Stack(t *testing.T) {
+	// Test that the stack of the panicking goroutine is captured
+	_, err := Try(func() int {
+		return panickingHelper()
+	})
+
+	var pe *PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("Expected *PanicError, got %T", err)
+	}
+	if len(pe.Stack) == 0 {
+		t.Fatal("Expected stack to be captured")
+	}
+	if !strings.Contains(string(pe.Stack), "panickingHelper") {
+		t.Errorf("Expected stack to contain panicking frame, got:\n%s", pe.Stack)
+	}
+}
+
+func panickingHelper() int {
+	panic("helper panic")
+}
+
+func TestPanicError_Unwrap(t *testing.T) {
+	// Test that errors thrown through Must are visible to errors.Is and errors.As
+	t.Run("errors_is", func(t *testing.T) {
+		_, err := Try(func() []byte {
+			return Must([]byte(nil), io.EOF)
+		})
+
+		if !errors.Is(err, io.EOF) {
+			t.Errorf("Expected errors.Is(err, io.EOF) to be true, err=%v", err)
+		}
+	})
+
+	t.Run("errors_as", func(t *testing.T) {
+		_, err := Try(func() int {
+			return Must(0, error(&testCodeError{Code: 42}))
+		})
+
+		var ce *testCodeError
+		if !errors.As(err, &ce) {
+			t.Fatalf("Expected errors.As to find *testCodeError, err=%v", err)
+		}
+		if ce.Code != 42 {
+			t.Errorf("Expected code 42, got %d", ce.Code)
+		}
+	})
+
+	t.Run("non_error_value", func(t *testing.T) {
+		pe := &PanicError{Value: "not an error"}
+		if pe.Unwrap() != nil {
+			t.Errorf("Expected nil from Unwrap, got %v", pe.Unwrap())
+		}
+	})
+}
+
+func TestPanicError_Error(t *testing.T) {
+	// Test the error message format
+	pe := &PanicError{Value: "something went wrong"}
+
+	expected := "panic: something went wrong"
+	if pe.Error() != expected {
+		t.Errorf("Expected error message %q, got %q", expected, pe.Error())
+	}
+}
+
+func TestPanicError_Classification(t *testing.T) {
+	// Test that runtime errors are classified into sentinel errors
+	sentinels := []error{
+		ErrNilDereference,
+		ErrIndexOutOfRange,
+		ErrDivideByZero,
+		ErrTypeAssertion,
+		ErrClosedChannel,
+		ErrMapWrite,
+	}
+
+	tests := []struct {
+		name     string
+		f        func()
+		expected error
+	}{
+		{"nil_dereference", func() {
+			var p *int
+			_ = *p
+		}, ErrNilDereference},
+		{"index_out_of_range", func() {
+			arr := []int{1, 2, 3}
+			i := 10
+			_ = arr[i]
+		}, ErrIndexOutOfRange},
+		{"slice_bounds_out_of_range", func() {
+			arr := []int{1, 2, 3}
+			i := 10
+			_ = arr[:i]
+		}, ErrIndexOutOfRange},
+		{"divide_by_zero", func() {
+			a, b := 10, 0
+			_ = a / b
+		}, ErrDivideByZero},
+		{"type_assertion", func() {
+			var v any = "string"
+			_ = v.(int)
+		}, ErrTypeAssertion},
+		{"send_on_closed_channel", func() {
+			ch := make(chan int, 1)
+			close(ch)
+			ch <- 1
+		}, ErrClosedChannel},
+		{"close_of_closed_channel", func() {
+			ch := make(chan int)
+			close(ch)
+			close(ch)
+		}, ErrClosedChannel},
+		{"nil_map_write", func() {
+			var m map[string]int
+			m["key"] = 1
+		}, ErrMapWrite},
+		{"must_panic", func() {
+			Must0(io.EOF)
+		}, nil},
+		{"string_panic", func() {
+			panic("boom")
+		}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Try0(tt.f)
+			if err == nil {
+				t.Fatal("Expected error, got nil")
+			}
+			for _, sentinel := range sentinels {
+				if got := errors.Is(err, sentinel); got != (sentinel == tt.expected) {
+					t.Errorf("Expected errors.Is(err, %v) to be %t, err=%v", sentinel, !got, err)
+				}
+			}
+		})
+	}
+}
+
+func TestPanicError_ClassificationKeepsUnwrap(t *testing.T) {
+	// Test that classification does not hide the wrapped error
+	_, err := Try(func() int {
+		return Must(0, io.EOF)
+	})
+
+	if !errors.Is(err, io.EOF) {
+		t.Errorf("Expected errors.Is(err, io.EOF) to be true, err=%v", err)
+	}
+	if errors.Is(err, ErrNilDereference) {
+		t.Error("Expected Must panic not to be classified as a runtime error")
+	}
+}
+
+func TestPanicError_LogValue(t *testing.T) {
+	// Test that a PanicError logs as a group of value, type and stack
+	_, err := Try(func() int {
+		return panickingHelper()
+	})
+
+	var pe *PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("Expected *PanicError, got %T", err)
+	}
+
+	v := slog.AnyValue(pe).Resolve()
+	if v.Kind() != slog.KindGroup {
+		t.Fatalf("Expected group value, got %v", v.Kind())
+	}
+
+	attrs := map[string]slog.Value{}
+	for _, a := range v.Group() {
+		attrs[a.Key] = a.Value
+	}
+	if got := attrs["value"].Any(); got != "helper panic" {
+		t.Errorf("Expected value %q, got %v", "helper panic", got)
+	}
+	if got := attrs["type"].String(); got != "string" {
+		t.Errorf("Expected type %q, got %q", "string", got)
+	}
+	if got := attrs["stack"].String(); !strings.Contains(got, "panickingHelper") {
+		t.Errorf("Expected stack to contain panicking frame, got:\n%s", got)
+	}
+}
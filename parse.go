@@ -0,0 +1,187 @@
+package sugar
+
+import (
+	"encoding"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// parser converts a string into a value of one specific type.
+type parser func(s string) (reflect.Value, error)
+
+// parsers holds the type-specific parsers consulted by Parse, Env and
+// ApplyDefaults before falling back to encoding.TextUnmarshaler and the
+// basic kinds. It is pre-populated with the standard library types that need
+// a dedicated parse function and extended with RegisterParser.
+var parsers = struct {
+	sync.RWMutex
+	m map[reflect.Type]parser
+}{m: make(map[reflect.Type]parser)}
+
+func init() {
+	RegisterParser(time.ParseDuration)
+	RegisterParser(func(s string) (time.Time, error) {
+		return time.Parse(time.RFC3339, s)
+	})
+	RegisterParser(func(s string) (url.URL, error) {
+		u, err := url.Parse(s)
+		if err != nil {
+			return url.URL{}, err
+		}
+		return *u, nil
+	})
+	RegisterParser(netip.ParseAddr)
+	RegisterParser(netip.ParseAddrPort)
+	RegisterParser(netip.ParsePrefix)
+}
+
+// RegisterParser makes f the parser for type T, used by Parse, MustParse, Env
+// and ApplyDefaults. A parser registered for T is also used for *T and []T.
+// Registering a parser for a type that already has one, including the
+// built-in types, replaces it.
+//
+// RegisterParser is safe for concurrent use, but is typically called from an
+// init function.
+//
+// Example usage:
+//
+//	func init() {
+//	    sugar.RegisterParser(func(s string) (Level, error) {
+//	        switch strings.ToLower(s) {
+//	        case "debug":
+//	            return LevelDebug, nil
+//	        case "info":
+//	            return LevelInfo, nil
+//	        }
+//	        return 0, fmt.Errorf("unknown level %q", s)
+//	    })
+//	}
+func RegisterParser[T any](f func(string) (T, error)) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+
+	parsers.Lock()
+	defer parsers.Unlock()
+	parsers.m[t] = func(s string) (reflect.Value, error) {
+		v, err := f(s)
+		return reflect.ValueOf(&v).Elem(), err
+	}
+}
+
+// Parse converts s into a value of type T. It dispatches on T in this order:
+//   - a parser registered for T with RegisterParser
+//   - the built-in parsers: time.ParseDuration for time.Duration, time.Parse
+//     with time.RFC3339 for time.Time, url.Parse for url.URL, and
+//     netip.ParseAddr, ParseAddrPort and ParsePrefix for the net/netip types
+//   - encoding.TextUnmarshaler, if *T implements it
+//   - strconv for strings, booleans, integers, unsigned integers and floats;
+//     integers are always decimal, so "010" is 10 rather than octal 8
+//   - for pointers, a newly allocated value parsed as the element type
+//   - for byte slices, the bytes of s
+//   - for other slices, a comma-separated list with each element parsed as
+//     the element type
+//
+// On failure Parse returns Zero[T]() and an error naming the input and T.
+//
+// Example usage:
+//
+//	port, err := Parse[uint16]("8080")
+//	timeout, err := Parse[time.Duration]("1m30s")
+//	addr, err := Parse[netip.Addr]("10.0.0.1")
+//	ports, err := Parse[[]int]("80,443")
+func Parse[T any](s string) (T, error) {
+	var v T
+	if err := setString(reflect.ValueOf(&v).Elem(), s); err != nil {
+		return Zero[T](), fmt.Errorf("sugar: cannot parse %q as %T: %w", s, v, err)
+	}
+	return v, nil
+}
+
+// MustParse is like Parse but panics with the error if s cannot be parsed.
+//
+//	var defaultTimeout = MustParse[time.Duration]("30s")
+func MustParse[T any](s string) T {
+	return Must(Parse[T](s))
+}
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// setString parses s according to the type of v and stores the result in v,
+// which must be settable.
+func setString(v reflect.Value, s string) error {
+	parsers.RLock()
+	p, ok := parsers.m[v.Type()]
+	parsers.RUnlock()
+	if ok {
+		parsed, err := p(s)
+		if err != nil {
+			return err
+		}
+		v.Set(parsed)
+		return nil
+	}
+
+	if reflect.PointerTo(v.Type()).Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(n)
+	case reflect.Pointer:
+		p := reflect.New(v.Type().Elem())
+		if err := setString(p.Elem(), s); err != nil {
+			return err
+		}
+		v.Set(p)
+	case reflect.Slice:
+		// Byte slices hold the raw bytes of s rather than a list of numbers.
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			v.SetBytes([]byte(s))
+			return nil
+		}
+		var parts []string
+		if s != "" {
+			parts = strings.Split(s, ",")
+		}
+		slice := reflect.MakeSlice(v.Type(), len(parts), len(parts))
+		for i, part := range parts {
+			if err := setString(slice.Index(i), strings.TrimSpace(part)); err != nil {
+				return fmt.Errorf("element %d: %w", i, err)
+			}
+		}
+		v.Set(slice)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+package sugar
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+type parseLevel int
+
+type parseColor struct {
+	R, G, B uint8
+}
+
+func (c *parseColor) UnmarshalText(text []byte) error {
+	_, err := fmt.Sscanf(string(text), "#%02x%02x%02x", &c.R, &c.G, &c.B)
+	return err
+}
+
+func TestParse_BasicTypes(t *testing.T) {
+	// Test parsing of basic kinds through strconv
+	t.Run("string", func(t *testing.T) {
+		if v, err := Parse[string]("hello"); err != nil || v != "hello" {
+			t.Errorf("Expected (hello, nil), got (%q, %v)", v, err)
+		}
+	})
+
+	t.Run("int", func(t *testing.T) {
+		if v, err := Parse[int]("-42"); err != nil || v != -42 {
+			t.Errorf("Expected (-42, nil), got (%d, %v)", v, err)
+		}
+	})
+
+	t.Run("uint16", func(t *testing.T) {
+		if v, err := Parse[uint16]("8080"); err != nil || v != 8080 {
+			t.Errorf("Expected (8080, nil), got (%d, %v)", v, err)
+		}
+		if _, err := Parse[uint16]("70000"); err == nil {
+			t.Error("Expected overflow error")
+		}
+	})
+
+	t.Run("decimal_only", func(t *testing.T) {
+		if v, err := Parse[int]("010"); err != nil || v != 10 {
+			t.Errorf("Expected (10, nil), got (%d, %v)", v, err)
+		}
+		if v, err := Parse[uint16]("08080"); err != nil || v != 8080 {
+			t.Errorf("Expected (8080, nil), got (%d, %v)", v, err)
+		}
+		if _, err := Parse[int]("0x1F"); err == nil {
+			t.Error("Expected error for hexadecimal literal")
+		}
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		if v, err := Parse[float64]("3.5"); err != nil || v != 3.5 {
+			t.Errorf("Expected (3.5, nil), got (%f, %v)", v, err)
+		}
+	})
+
+	t.Run("bool", func(t *testing.T) {
+		if v, err := Parse[bool]("true"); err != nil || v != true {
+			t.Errorf("Expected (true, nil), got (%t, %v)", v, err)
+		}
+	})
+}
+
+func TestParse_StandardTypes(t *testing.T) {
+	// Test the built-in parsers for standard library types
+	t.Run("duration", func(t *testing.T) {
+		if v, err := Parse[time.Duration]("1m30s"); err != nil || v != 90*time.Second {
+			t.Errorf("Expected (1m30s, nil), got (%v, %v)", v, err)
+		}
+	})
+
+	t.Run("time", func(t *testing.T) {
+		expected := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		if v, err := Parse[time.Time]("2024-01-02T03:04:05Z"); err != nil || !v.Equal(expected) {
+			t.Errorf("Expected (%v, nil), got (%v, %v)", expected, v, err)
+		}
+		if _, err := Parse[time.Time]("2024-01-02"); err == nil {
+			t.Error("Expected error for non-RFC3339 time")
+		}
+	})
+
+	t.Run("url", func(t *testing.T) {
+		v, err := Parse[*url.URL]("https://example.com/path?q=1")
+		if err != nil || v.Host != "example.com" || v.RawQuery != "q=1" {
+			t.Errorf("Expected https://example.com/path?q=1, got (%v, %v)", v, err)
+		}
+	})
+
+	t.Run("netip", func(t *testing.T) {
+		if v, err := Parse[netip.Addr]("10.0.0.1"); err != nil || v != netip.MustParseAddr("10.0.0.1") {
+			t.Errorf("Expected (10.0.0.1, nil), got (%v, %v)", v, err)
+		}
+		if v, err := Parse[netip.AddrPort]("10.0.0.1:80"); err != nil || v.Port() != 80 {
+			t.Errorf("Expected (10.0.0.1:80, nil), got (%v, %v)", v, err)
+		}
+		if v, err := Parse[netip.Prefix]("10.0.0.0/8"); err != nil || v.Bits() != 8 {
+			t.Errorf("Expected (10.0.0.0/8, nil), got (%v, %v)", v, err)
+		}
+	})
+
+	t.Run("text_unmarshaler", func(t *testing.T) {
+		v, err := Parse[parseColor]("#ff8000")
+		if err != nil || v != (parseColor{255, 128, 0}) {
+			t.Errorf("Expected ({255 128 0}, nil), got (%v, %v)", v, err)
+		}
+	})
+}
+
+func TestParse_Composite(t *testing.T) {
+	// Test pointers and comma-separated slices
+	t.Run("pointer", func(t *testing.T) {
+		if v, err := Parse[*int]("42"); err != nil || v == nil || *v != 42 {
+			t.Errorf("Expected pointer to 42, got (%v, %v)", v, err)
+		}
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		v, err := Parse[[]time.Duration]("1s, 2s")
+		if err != nil || len(v) != 2 || v[0] != time.Second || v[1] != 2*time.Second {
+			t.Errorf("Expected [1s 2s], got (%v, %v)", v, err)
+		}
+	})
+
+	t.Run("byte_slice", func(t *testing.T) {
+		if v, err := Parse[[]byte]("hello, world"); err != nil || string(v) != "hello, world" {
+			t.Errorf("Expected raw bytes %q, got (%q, %v)", "hello, world", v, err)
+		}
+		type secret []byte
+		if v, err := Parse[secret]("s3cr3t"); err != nil || string(v) != "s3cr3t" {
+			t.Errorf("Expected raw bytes %q, got (%q, %v)", "s3cr3t", v, err)
+		}
+	})
+
+	t.Run("empty_slice", func(t *testing.T) {
+		if v, err := Parse[[]int](""); err != nil || len(v) != 0 {
+			t.Errorf("Expected empty slice, got (%v, %v)", v, err)
+		}
+	})
+
+	t.Run("slice_element_error", func(t *testing.T) {
+		_, err := Parse[[]int]("1,x")
+		if err == nil || !strings.Contains(err.Error(), "element 1") {
+			t.Errorf("Expected error naming element 1, got %v", err)
+		}
+	})
+}
+
+func TestParse_Errors(t *testing.T) {
+	// Test that errors name the input and the target type
+	v, err := Parse[int]("eighty")
+	if v != 0 {
+		t.Errorf("Expected zero value, got %d", v)
+	}
+	expected := `sugar: cannot parse "eighty" as int: `
+	if err == nil || !strings.HasPrefix(err.Error(), expected) {
+		t.Errorf("Expected error message to start with %q, got %v", expected, err)
+	}
+
+	if _, err := Parse[chan int]("1"); err == nil || !strings.Contains(err.Error(), "unsupported type") {
+		t.Errorf("Expected unsupported type error, got %v", err)
+	}
+}
+
+func TestRegisterParser(t *testing.T) {
+	// Test that registered parsers are used by Parse, Env and ApplyDefaults
+	errUnknownLevel := errors.New("unknown level")
+	RegisterParser(func(s string) (parseLevel, error) {
+		switch s {
+		case "debug":
+			return 1, nil
+		case "info":
+			return 2, nil
+		}
+		return 0, errUnknownLevel
+	})
+
+	t.Run("parse", func(t *testing.T) {
+		if v, err := Parse[parseLevel]("info"); err != nil || v != 2 {
+			t.Errorf("Expected (2, nil), got (%d, %v)", v, err)
+		}
+		if _, err := Parse[parseLevel]("2"); !errors.Is(err, errUnknownLevel) {
+			t.Errorf("Expected registered parser error, got %v", err)
+		}
+	})
+
+	t.Run("pointer_and_slice", func(t *testing.T) {
+		if v, err := Parse[*parseLevel]("debug"); err != nil || *v != 1 {
+			t.Errorf("Expected pointer to 1, got (%v, %v)", v, err)
+		}
+		if v, err := Parse[[]parseLevel]("debug,info"); err != nil || len(v) != 2 || v[1] != 2 {
+			t.Errorf("Expected [1 2], got (%v, %v)", v, err)
+		}
+	})
+
+	t.Run("env", func(t *testing.T) {
+		lookup := testEnvLookup(map[string]string{"LEVEL": "debug"})
+		if v, err := Env[parseLevel]("LEVEL", lookup); err != nil || v != 1 {
+			t.Errorf("Expected (1, nil), got (%d, %v)", v, err)
+		}
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		var cfg struct {
+			Level parseLevel `default:"info"`
+		}
+		if err := ApplyDefaults(&cfg); err != nil || cfg.Level != 2 {
+			t.Errorf("Expected Level 2, got (%d, %v)", cfg.Level, err)
+		}
+	})
+}
+
+func TestMustParse(t *testing.T) {
+	// Test that MustParse returns the value or panics with the parse error
+	if v := MustParse[time.Duration]("30s"); v != 30*time.Second {
+		t.Errorf("Expected 30s, got %v", v)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected function to panic, but it didn't")
+		}
+	}()
+
+	MustParse[int]("not a number")
+}
+
+// Benchmark tests
+func BenchmarkParse_Int(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Parse[int]("8080")
+	}
+}
+
+func BenchmarkParse_Duration(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Parse[time.Duration]("1m30s")
+	}
+}
@@ -105,3 +105,63 @@ package sugar
 func Ptr[T any](v T) *T {
 	return &v
 }
+
+// Deref is the reverse of Ptr. It returns the value p points to, or Zero[T]()
+// if p is nil, which removes the nil check otherwise needed before every
+// dereference of an optional pointer field.
+//
+// Example usage:
+//
+//	type Config struct {
+//	    Timeout *int
+//	}
+//	timeout := Deref(cfg.Timeout) // 0 if Timeout is unset
+func Deref[T any](p *T) T {
+	if p == nil {
+		return Zero[T]()
+	}
+	return *p
+}
+
+// DerefOr returns the value p points to, or def if p is nil.
+//
+// Example usage:
+//
+//	timeout := DerefOr(cfg.Timeout, 30) // 30 if Timeout is unset
+func DerefOr[T any](p *T, def T) T {
+	if p == nil {
+		return def
+	}
+	return *p
+}
+
+// Coalesce returns the first non-nil pointer in ps, or nil if all of them are
+// nil. It is useful for layering optional values, with the highest-priority
+// source first.
+//
+// Example usage:
+//
+//	timeout := Coalesce(flagTimeout, envTimeout, fileTimeout, Ptr(30))
+func Coalesce[T any](ps ...*T) *T {
+	for _, p := range ps {
+		if p != nil {
+			return p
+		}
+	}
+	return nil
+}
+
+// NilIfZero returns nil if v is the zero value for T, and otherwise a pointer
+// to a copy of v created with Ptr. It is the inverse of Deref for types whose
+// zero value means "unset", such as filling optional pointer fields from plain
+// values:
+//
+//	resp := APIResponse{
+//	    Error: NilIfZero(errMsg), // omitted from JSON when errMsg is ""
+//	}
+func NilIfZero[T comparable](v T) *T {
+	if v == Zero[T]() {
+		return nil
+	}
+	return Ptr(v)
+}
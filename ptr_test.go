@@ -437,6 +437,156 @@ func TestPtr_UsagePatterns(t *testing.T) {
 	})
 }
 
+func TestDeref(t *testing.T) {
+	// Test Deref with nil and non-nil pointers of different types
+	t.Run("int", func(t *testing.T) {
+		if result := Deref(Ptr(42)); result != 42 {
+			t.Errorf("Expected 42, got %d", result)
+		}
+		if result := Deref[int](nil); result != 0 {
+			t.Errorf("Expected zero value 0, got %d", result)
+		}
+	})
+
+	t.Run("string", func(t *testing.T) {
+		if result := Deref(Ptr("hello")); result != "hello" {
+			t.Errorf("Expected %q, got %q", "hello", result)
+		}
+		if result := Deref[string](nil); result != "" {
+			t.Errorf("Expected zero value empty string, got %q", result)
+		}
+	})
+
+	t.Run("bool", func(t *testing.T) {
+		if result := Deref(Ptr(true)); result != true {
+			t.Errorf("Expected true, got %t", result)
+		}
+		if result := Deref[bool](nil); result != false {
+			t.Errorf("Expected zero value false, got %t", result)
+		}
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		if result := Deref(Ptr([]int{1, 2, 3})); len(result) != 3 {
+			t.Errorf("Expected slice of length 3, got %v", result)
+		}
+		if result := Deref[[]int](nil); result != nil {
+			t.Errorf("Expected zero value nil, got %v", result)
+		}
+	})
+
+	t.Run("struct", func(t *testing.T) {
+		type TestStruct struct {
+			Name string
+			Age  int
+		}
+		value := TestStruct{Name: "John", Age: 30}
+		if result := Deref(&value); result != value {
+			t.Errorf("Expected %+v, got %+v", value, result)
+		}
+		if result := Deref[TestStruct](nil); result != (TestStruct{}) {
+			t.Errorf("Expected zero value, got %+v", result)
+		}
+	})
+}
+
+func TestDerefOr(t *testing.T) {
+	// Test DerefOr returns the default only for nil pointers
+	t.Run("int", func(t *testing.T) {
+		if result := DerefOr(Ptr(42), 30); result != 42 {
+			t.Errorf("Expected 42, got %d", result)
+		}
+		if result := DerefOr(nil, 30); result != 30 {
+			t.Errorf("Expected default 30, got %d", result)
+		}
+	})
+
+	t.Run("zero_is_not_default", func(t *testing.T) {
+		// A pointer to a zero value is still a set value
+		if result := DerefOr(Ptr(0), 30); result != 0 {
+			t.Errorf("Expected 0, got %d", result)
+		}
+	})
+
+	t.Run("string", func(t *testing.T) {
+		if result := DerefOr(nil, "default"); result != "default" {
+			t.Errorf("Expected %q, got %q", "default", result)
+		}
+	})
+}
+
+func TestCoalesce(t *testing.T) {
+	// Test Coalesce returns the first non-nil pointer
+	first := Ptr(1)
+	second := Ptr(2)
+
+	if result := Coalesce(nil, first, second); result != first {
+		t.Errorf("Expected first non-nil pointer, got %v", result)
+	}
+	if result := Coalesce(second, first); result != second {
+		t.Errorf("Expected first argument, got %v", result)
+	}
+	if result := Coalesce[int](nil, nil); result != nil {
+		t.Errorf("Expected nil, got %v", result)
+	}
+	if result := Coalesce[string](); result != nil {
+		t.Errorf("Expected nil for no arguments, got %v", result)
+	}
+}
+
+func TestNilIfZero(t *testing.T) {
+	// Test NilIfZero with zero and non-zero values of different types
+	t.Run("int", func(t *testing.T) {
+		if result := NilIfZero(0); result != nil {
+			t.Errorf("Expected nil, got %v", result)
+		}
+		if result := NilIfZero(42); result == nil || *result != 42 {
+			t.Errorf("Expected pointer to 42, got %v", result)
+		}
+	})
+
+	t.Run("string", func(t *testing.T) {
+		if result := NilIfZero(""); result != nil {
+			t.Errorf("Expected nil, got %v", result)
+		}
+		if result := NilIfZero("hello"); result == nil || *result != "hello" {
+			t.Errorf("Expected pointer to hello, got %v", result)
+		}
+	})
+
+	t.Run("bool", func(t *testing.T) {
+		if result := NilIfZero(false); result != nil {
+			t.Errorf("Expected nil, got %v", result)
+		}
+		if result := NilIfZero(true); result == nil || *result != true {
+			t.Errorf("Expected pointer to true, got %v", result)
+		}
+	})
+
+	t.Run("struct", func(t *testing.T) {
+		type TestStruct struct {
+			Name string
+			Age  int
+		}
+		if result := NilIfZero(TestStruct{}); result != nil {
+			t.Errorf("Expected nil, got %v", result)
+		}
+		value := TestStruct{Name: "John"}
+		if result := NilIfZero(value); result == nil || *result != value {
+			t.Errorf("Expected pointer to %+v, got %v", value, result)
+		}
+	})
+
+	t.Run("round_trip", func(t *testing.T) {
+		if result := Deref(NilIfZero(0)); result != 0 {
+			t.Errorf("Expected 0, got %d", result)
+		}
+		if result := Deref(NilIfZero(42)); result != 42 {
+			t.Errorf("Expected 42, got %d", result)
+		}
+	})
+}
+
 // Benchmark tests
 func BenchmarkPtr_Int(b *testing.B) {
 	b.ResetTimer()
@@ -482,3 +632,12 @@ func BenchmarkPtr_Map(b *testing.B) {
 		Ptr(m)
 	}
 }
+
+func BenchmarkDeref_Int(b *testing.B) {
+	p := Ptr(42)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Deref(p)
+	}
+}
@@ -0,0 +1,130 @@
+package sugar
+
+// Result holds the outcome of an operation that either produced a value of
+// type T or failed with an error. It is a plain value type, so it can be sent
+// over channels, stored in slices and maps, and passed between goroutines
+// without defining an ad-hoc struct{ v T; err error } in every package.
+//
+// Result bridges the other utilities in this package: Of captures panics with
+// Try, Must panics like Must, and Handle applies a Handler like Handle.
+//
+// The zero value is a successful Result holding Zero[T]().
+//
+// Example usage:
+//
+//	results := make(chan Result[Page])
+//	for _, url := range urls {
+//	    go func() { results <- FromPair(fetch(url)) }()
+//	}
+//	for range urls {
+//	    page, err := (<-results).Get()
+//	    // ...
+//	}
+type Result[T any] struct {
+	v   T
+	err error
+}
+
+// Ok returns a successful Result holding v.
+func Ok[T any](v T) Result[T] {
+	return Result[T]{v: v}
+}
+
+// Err returns a failed Result holding err and Zero[T](). Passing a nil error
+// yields a successful Result holding Zero[T]().
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// FromPair builds a Result from the (value, error) pair returned by an
+// ordinary Go function call:
+//
+//	r := FromPair(os.ReadFile("config.json"))
+//
+// The value is kept even if err is non-nil, so Get returns exactly the pair
+// that was passed in.
+func FromPair[T any](v T, err error) Result[T] {
+	return Result[T]{v: v, err: err}
+}
+
+// Of runs f under Try and captures its outcome. If f panics, the Result holds
+// the *PanicError returned by Try.
+func Of[T any](f func() T) Result[T] {
+	return FromPair(Try(f))
+}
+
+// Get returns the value and error held by r.
+func (r Result[T]) Get() (T, error) {
+	return r.v, r.err
+}
+
+// IsOk reports whether r holds no error.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// Err returns the error held by r, or nil if r is successful.
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Must returns the value held by r, or panics with its error like Must.
+func (r Result[T]) Must() T {
+	return Must(r.v, r.err)
+}
+
+// Or returns the value held by r, or def if r holds an error.
+func (r Result[T]) Or(def T) T {
+	if r.err != nil {
+		return def
+	}
+	return r.v
+}
+
+// OrElse returns the value held by r, or the value computed by f from the
+// error if r holds one.
+func (r Result[T]) OrElse(f func(error) T) T {
+	if r.err != nil {
+		return f(r.err)
+	}
+	return r.v
+}
+
+// Handle applies h to the error held by r with the semantics of Handle: a
+// successful Result or an error handled by h yields the value held by r, and
+// an error returned by h is panicked with.
+func (r Result[T]) Handle(h Handler[T]) T {
+	return Handle(h)(r.v, r.err)
+}
+
+// MapResult transforms the value held by a successful Result with f. A failed
+// Result is passed through with its error and f is not called.
+//
+// Example usage:
+//
+//	size := MapResult(FromPair(os.ReadFile(path)), func(b []byte) int {
+//	    return len(b)
+//	})
+func MapResult[T, U any](r Result[T], f func(T) U) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return Ok(f(r.v))
+}
+
+// AndThen chains a fallible operation onto a successful Result. A failed
+// Result is passed through with its error and f is not called.
+//
+// Example usage:
+//
+//	cfg := AndThen(FromPair(os.ReadFile(path)), func(b []byte) Result[Config] {
+//	    var cfg Config
+//	    err := json.Unmarshal(b, &cfg)
+//	    return FromPair(cfg, err)
+//	})
+func AndThen[T, U any](r Result[T], f func(T) Result[U]) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return f(r.v)
+}
@@ -0,0 +1,164 @@
+package sugar
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestResult_Constructors(t *testing.T) {
+	// Test the ways of building a Result
+	testErr := errors.New("test error")
+
+	t.Run("ok", func(t *testing.T) {
+		v, err := Ok(42).Get()
+		if err != nil || v != 42 {
+			t.Errorf("Expected (42, nil), got (%d, %v)", v, err)
+		}
+	})
+
+	t.Run("err", func(t *testing.T) {
+		v, err := Err[string](testErr).Get()
+		if err != testErr || v != "" {
+			t.Errorf("Expected (\"\", %v), got (%q, %v)", testErr, v, err)
+		}
+	})
+
+	t.Run("from_pair", func(t *testing.T) {
+		v, err := FromPair(strconv.Atoi("123")).Get()
+		if err != nil || v != 123 {
+			t.Errorf("Expected (123, nil), got (%d, %v)", v, err)
+		}
+
+		v, err = FromPair(-1, testErr).Get()
+		if err != testErr || v != -1 {
+			t.Errorf("Expected (-1, %v), got (%d, %v)", testErr, v, err)
+		}
+	})
+
+	t.Run("of", func(t *testing.T) {
+		r := Of(func() int { return 42 })
+		if !r.IsOk() || r.Or(0) != 42 {
+			t.Errorf("Expected successful Result with 42, got %+v", r)
+		}
+
+		r = Of(func() int { panic("boom") })
+		var pe *PanicError
+		if !errors.As(r.Err(), &pe) {
+			t.Errorf("Expected *PanicError, got %v", r.Err())
+		}
+	})
+
+	t.Run("zero_value", func(t *testing.T) {
+		var r Result[int]
+		if !r.IsOk() || r.Err() != nil {
+			t.Errorf("Expected zero Result to be successful, got %+v", r)
+		}
+	})
+}
+
+func TestResult_Accessors(t *testing.T) {
+	// Test extracting values from successful and failed Results
+	testErr := errors.New("test error")
+	ok := Ok(42)
+	failed := Err[int](testErr)
+
+	t.Run("or", func(t *testing.T) {
+		if ok.Or(7) != 42 {
+			t.Errorf("Expected 42, got %d", ok.Or(7))
+		}
+		if failed.Or(7) != 7 {
+			t.Errorf("Expected 7, got %d", failed.Or(7))
+		}
+	})
+
+	t.Run("or_else", func(t *testing.T) {
+		f := func(err error) int {
+			if err != testErr {
+				t.Errorf("Expected %v, got %v", testErr, err)
+			}
+			return 7
+		}
+		if ok.OrElse(f) != 42 {
+			t.Errorf("Expected 42, got %d", ok.OrElse(f))
+		}
+		if failed.OrElse(f) != 7 {
+			t.Errorf("Expected 7, got %d", failed.OrElse(f))
+		}
+	})
+
+	t.Run("must", func(t *testing.T) {
+		if ok.Must() != 42 {
+			t.Errorf("Expected 42, got %d", ok.Must())
+		}
+
+		defer func() {
+			if r := recover(); r != testErr {
+				t.Errorf("Expected panic with %v, got %v", testErr, r)
+			}
+		}()
+		failed.Must()
+		t.Error("Expected function to panic, but it didn't")
+	})
+
+	t.Run("handle", func(t *testing.T) {
+		if v := failed.Handle(IgnoreIs[int](testErr)); v != 0 {
+			t.Errorf("Expected 0, got %d", v)
+		}
+
+		defer func() {
+			if r := recover(); r != testErr {
+				t.Errorf("Expected panic with %v, got %v", testErr, r)
+			}
+		}()
+		failed.Handle(func(err error) error { return err })
+		t.Error("Expected function to panic, but it didn't")
+	})
+}
+
+func TestMapResult(t *testing.T) {
+	// Test that MapResult transforms values and passes errors through
+	r := MapResult(Ok("hello"), func(s string) int { return len(s) })
+	if v, err := r.Get(); err != nil || v != 5 {
+		t.Errorf("Expected (5, nil), got (%d, %v)", v, err)
+	}
+
+	testErr := errors.New("test error")
+	r = MapResult(Err[string](testErr), func(s string) int {
+		t.Error("Expected f not to be called for a failed Result")
+		return 0
+	})
+	if r.Err() != testErr {
+		t.Errorf("Expected %v, got %v", testErr, r.Err())
+	}
+}
+
+func TestAndThen(t *testing.T) {
+	// Test that AndThen chains fallible operations
+	parse := func(s string) Result[int] { return FromPair(strconv.Atoi(s)) }
+
+	if v, err := AndThen(Ok("42"), parse).Get(); err != nil || v != 42 {
+		t.Errorf("Expected (42, nil), got (%d, %v)", v, err)
+	}
+
+	if err := AndThen(Ok("not a number"), parse).Err(); err == nil {
+		t.Error("Expected error from failing step")
+	}
+
+	testErr := errors.New("test error")
+	r := AndThen(Err[string](testErr), func(s string) Result[int] {
+		t.Error("Expected f not to be called for a failed Result")
+		return Ok(0)
+	})
+	if r.Err() != testErr {
+		t.Errorf("Expected %v, got %v", testErr, r.Err())
+	}
+}
+
+// Benchmark tests
+func BenchmarkResult_FromPair(b *testing.B) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		FromPair(42, nil).Or(0)
+	}
+}
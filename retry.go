@@ -0,0 +1,209 @@
+package sugar
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// RetryClock is the source of time used by Retry. It exists so that tests can
+// run Retry deterministically, without real sleeps.
+type RetryClock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// Sleep waits for d, or returns ctx.Err() if ctx is done first.
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+func (realClock) Sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// RetryOption configures Retry.
+type RetryOption func(*retryConfig)
+
+type retryConfig struct {
+	maxAttempts int
+	maxElapsed  time.Duration
+	initial     time.Duration
+	max         time.Duration
+	multiplier  float64
+	jitter      float64
+	retryIf     func(error) error
+	clock       RetryClock
+}
+
+// WithMaxAttempts limits the number of attempts, including the first one.
+// The default is 3. A value of 0 or less removes the limit.
+func WithMaxAttempts(n int) RetryOption {
+	return func(c *retryConfig) {
+		c.maxAttempts = n
+	}
+}
+
+// WithMaxElapsed stops retrying once the next attempt would start more than d
+// after the first one. By default there is no limit.
+func WithMaxElapsed(d time.Duration) RetryOption {
+	return func(c *retryConfig) {
+		c.maxElapsed = d
+	}
+}
+
+// WithBackoff sets the delay before the first retry and the cap on later
+// delays, which grow exponentially by multiplier. The default is an initial
+// delay of 100ms, a cap of 10s and a multiplier of 2. A non-positive initial
+// or maxDelay, or a multiplier below 1, keeps the default for that value, so
+// a misconfiguration cannot make Retry spin without delay. The first delay is
+// capped by maxDelay too.
+func WithBackoff(initial, maxDelay time.Duration, multiplier float64) RetryOption {
+	return func(c *retryConfig) {
+		if initial > 0 {
+			c.initial = initial
+		}
+		if maxDelay > 0 {
+			c.max = maxDelay
+		}
+		if multiplier >= 1 {
+			c.multiplier = multiplier
+		}
+	}
+}
+
+// WithJitter randomizes every delay by up to the given fraction in either
+// direction, so that 0.2 yields delays between 80% and 120% of the computed
+// backoff. Jitter spreads out retries from many clients failing at once. The
+// default is 0.2; 0 disables jitter. A fraction outside [0, 1) is ignored, as
+// it could shrink delays to nothing.
+func WithJitter(fraction float64) RetryOption {
+	return func(c *retryConfig) {
+		if fraction >= 0 && fraction < 1 {
+			c.jitter = fraction
+		}
+	}
+}
+
+// WithRetryIf classifies errors with a Handler: if h returns nil the error is
+// retryable and Retry keeps going; if h returns an error, Retry stops
+// immediately and returns it. By default every error is retryable.
+//
+// Example usage:
+//
+//	// Only retry timeouts; give up on anything else
+//	WithRetryIf[*http.Response](func(err error) error {
+//	    var netErr net.Error
+//	    if errors.As(err, &netErr) && netErr.Timeout() {
+//	        return nil
+//	    }
+//	    return err
+//	})
+func WithRetryIf[T any](h Handler[T]) RetryOption {
+	return func(c *retryConfig) {
+		c.retryIf = h
+	}
+}
+
+// WithRetryClock replaces the real clock used to measure elapsed time and to
+// sleep between attempts.
+func WithRetryClock(clock RetryClock) RetryOption {
+	return func(c *retryConfig) {
+		c.clock = clock
+	}
+}
+
+// Retry calls f until it succeeds, an error is classified as not retryable,
+// the attempts or elapsed time run out, or ctx is done. Between attempts it
+// sleeps with exponential, jittered backoff.
+//
+// Every attempt runs under TryE, so a panicking attempt counts as a failed
+// attempt whose error is a *PanicError, and is retried like any other error
+// unless WithRetryIf says otherwise.
+//
+// On success Retry returns the result of the successful attempt. Otherwise it
+// returns Zero[T]() and either the error returned by the WithRetryIf handler,
+// or an error wrapping the last attempt's error (and ctx.Err() if the context
+// ended the retries), so errors.Is and errors.As see both.
+//
+// Example usage:
+//
+//	resp, err := Retry(ctx, func(ctx context.Context) (*http.Response, error) {
+//	    req := Must(http.NewRequestWithContext(ctx, "GET", url, nil))
+//	    return http.DefaultClient.Do(req)
+//	},
+//	    WithMaxAttempts(5),
+//	    WithBackoff(200*time.Millisecond, 5*time.Second, 2),
+//	    WithRetryIf(IgnoreAs[*http.Response, net.Error]()),
+//	)
+func Retry[T any](ctx context.Context, f func(context.Context) (T, error), opts ...RetryOption) (T, error) {
+	c := retryConfig{
+		maxAttempts: 3,
+		initial:     100 * time.Millisecond,
+		max:         10 * time.Second,
+		multiplier:  2,
+		jitter:      0.2,
+		retryIf:     func(error) error { return nil },
+		clock:       realClock{},
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	start := c.clock.Now()
+	delay := c.initial
+	if delay > c.max {
+		delay = c.max
+	}
+	for attempt := 1; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return Zero[T](), fmt.Errorf("sugar: retry stopped before attempt %d: %w", attempt, err)
+		}
+
+		v, err := TryE(func() (T, error) { return f(ctx) })
+		if err == nil {
+			return v, nil
+		}
+		if stop := c.retryIf(err); stop != nil {
+			return Zero[T](), stop
+		}
+
+		if c.maxAttempts > 0 && attempt >= c.maxAttempts {
+			return Zero[T](), fmt.Errorf("sugar: retry gave up after %d attempts: %w", attempt, err)
+		}
+
+		d := c.jittered(delay)
+		if c.maxElapsed > 0 && c.clock.Now().Add(d).Sub(start) > c.maxElapsed {
+			return Zero[T](), fmt.Errorf("sugar: retry gave up after %d attempts and %v: %w", attempt, c.maxElapsed, err)
+		}
+		if sleepErr := c.clock.Sleep(ctx, d); sleepErr != nil {
+			return Zero[T](), fmt.Errorf("sugar: retry stopped after %d attempts: %w (last error: %w)", attempt, sleepErr, err)
+		}
+
+		delay = time.Duration(float64(delay) * c.multiplier)
+		if delay > c.max {
+			delay = c.max
+		}
+	}
+}
+
+func (c *retryConfig) jittered(d time.Duration) time.Duration {
+	if c.jitter <= 0 {
+		return d
+	}
+	j := time.Duration(float64(d) * (1 + c.jitter*(2*rand.Float64()-1)))
+	if j <= 0 {
+		j = 1
+	}
+	return j
+}
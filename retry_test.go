@@ -0,0 +1,297 @@
+package sugar
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// fakeClock records sleeps and advances its time by them instantly.
+type fakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Sleep(ctx context.Context, d time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+	return nil
+}
+
+// failingN returns a function that fails n times before returning value.
+func failingN(n int, err error, value string) (func(context.Context) (string, error), *int) {
+	calls := 0
+	return func(ctx context.Context) (string, error) {
+		calls++
+		if calls <= n {
+			return "", err
+		}
+		return value, nil
+	}, &calls
+}
+
+func TestRetry_Success(t *testing.T) {
+	// Test that Retry returns as soon as an attempt succeeds
+	clock := &fakeClock{}
+	f, calls := failingN(2, errors.New("transient"), "ok")
+
+	result, err := Retry(context.Background(), f, WithRetryClock(clock), WithJitter(0))
+
+	if err != nil || result != "ok" {
+		t.Errorf("Expected (ok, nil), got (%q, %v)", result, err)
+	}
+	if *calls != 3 {
+		t.Errorf("Expected 3 attempts, got %d", *calls)
+	}
+}
+
+func TestRetry_Backoff(t *testing.T) {
+	// Test that delays grow exponentially up to the cap
+	clock := &fakeClock{}
+	f, _ := failingN(10, errors.New("transient"), "ok")
+
+	Retry(context.Background(), f,
+		WithRetryClock(clock),
+		WithJitter(0),
+		WithMaxAttempts(6),
+		WithBackoff(100*time.Millisecond, time.Second, 3),
+	)
+
+	expected := []time.Duration{
+		100 * time.Millisecond,
+		300 * time.Millisecond,
+		900 * time.Millisecond,
+		time.Second,
+		time.Second,
+	}
+	if len(clock.sleeps) != len(expected) {
+		t.Fatalf("Expected sleeps %v, got %v", expected, clock.sleeps)
+	}
+	for i := range expected {
+		if clock.sleeps[i] != expected[i] {
+			t.Errorf("Expected sleep %d to be %v, got %v", i, expected[i], clock.sleeps[i])
+		}
+	}
+}
+
+func TestRetry_BackoffInvalid(t *testing.T) {
+	// Test that invalid backoff and jitter values are ignored
+	tests := []struct {
+		name     string
+		opt      RetryOption
+		expected []time.Duration
+	}{
+		{"all_zero", WithBackoff(0, 0, 0), []time.Duration{
+			100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond,
+		}},
+		{"negative", WithBackoff(-time.Second, -time.Second, -2), []time.Duration{
+			100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond,
+		}},
+		{"shrinking_multiplier", WithBackoff(time.Second, time.Minute, 0.5), []time.Duration{
+			time.Second, 2 * time.Second, 4 * time.Second,
+		}},
+		{"initial_above_cap", WithBackoff(time.Minute, time.Second, 2), []time.Duration{
+			time.Second, time.Second, time.Second,
+		}},
+		{"jitter_out_of_range", WithJitter(3), []time.Duration{
+			100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond,
+		}},
+		{"negative_jitter", WithJitter(-0.5), []time.Duration{
+			100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := &fakeClock{}
+			f, _ := failingN(10, errors.New("transient"), "ok")
+
+			Retry(context.Background(), f, WithRetryClock(clock), WithJitter(0), WithMaxAttempts(4), tt.opt)
+
+			if len(clock.sleeps) != len(tt.expected) {
+				t.Fatalf("Expected sleeps %v, got %v", tt.expected, clock.sleeps)
+			}
+			for i := range tt.expected {
+				if clock.sleeps[i] != tt.expected[i] {
+					t.Errorf("Expected sleep %d to be %v, got %v", i, tt.expected[i], clock.sleeps[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRetry_Jitter(t *testing.T) {
+	// Test that jittered delays stay within the configured fraction
+	clock := &fakeClock{}
+	f, _ := failingN(100, errors.New("transient"), "ok")
+
+	Retry(context.Background(), f,
+		WithRetryClock(clock),
+		WithJitter(0.5),
+		WithMaxAttempts(50),
+		WithBackoff(time.Second, time.Second, 1),
+	)
+
+	for i, d := range clock.sleeps {
+		if d < 500*time.Millisecond || d > 1500*time.Millisecond {
+			t.Errorf("Expected sleep %d within [500ms, 1.5s], got %v", i, d)
+		}
+	}
+}
+
+func TestRetry_MaxAttempts(t *testing.T) {
+	// Test that Retry gives up after the maximum number of attempts
+	transient := errors.New("transient")
+	f, calls := failingN(10, transient, "ok")
+
+	result, err := Retry(context.Background(), f, WithRetryClock(&fakeClock{}), WithMaxAttempts(4))
+
+	if result != "" {
+		t.Errorf("Expected zero value, got %q", result)
+	}
+	if !errors.Is(err, transient) {
+		t.Errorf("Expected error wrapping %v, got %v", transient, err)
+	}
+	if *calls != 4 {
+		t.Errorf("Expected 4 attempts, got %d", *calls)
+	}
+}
+
+func TestRetry_MaxElapsed(t *testing.T) {
+	// Test that Retry gives up when the next attempt would exceed the time budget
+	clock := &fakeClock{}
+	transient := errors.New("transient")
+	f, calls := failingN(10, transient, "ok")
+
+	_, err := Retry(context.Background(), f,
+		WithRetryClock(clock),
+		WithJitter(0),
+		WithMaxAttempts(0),
+		WithMaxElapsed(time.Second),
+		WithBackoff(200*time.Millisecond, time.Minute, 2),
+	)
+
+	// Sleeps of 200ms and 400ms fit in 1s; the next 800ms sleep does not
+	if !errors.Is(err, transient) {
+		t.Errorf("Expected error wrapping %v, got %v", transient, err)
+	}
+	if *calls != 3 {
+		t.Errorf("Expected 3 attempts, got %d", *calls)
+	}
+}
+
+func TestRetry_RetryIf(t *testing.T) {
+	// Test that the handler decides which errors are retryable
+	transient := errors.New("transient")
+	permanent := errors.New("permanent")
+	calls := 0
+	f := func(ctx context.Context) (int, error) {
+		calls++
+		if calls == 1 {
+			return 0, transient
+		}
+		return 0, permanent
+	}
+
+	_, err := Retry(context.Background(), f,
+		WithRetryClock(&fakeClock{}),
+		WithMaxAttempts(10),
+		WithRetryIf(IgnoreIs[int](transient)),
+	)
+
+	if err != permanent {
+		t.Errorf("Expected %v, got %v", permanent, err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 attempts, got %d", calls)
+	}
+}
+
+func TestRetry_RecoversPanics(t *testing.T) {
+	// Test that a panicking attempt is recovered and retried
+	calls := 0
+	f := func(ctx context.Context) (int, error) {
+		calls++
+		if calls == 1 {
+			var m map[string]int
+			m["key"] = 1
+		}
+		return 42, nil
+	}
+
+	result, err := Retry(context.Background(), f, WithRetryClock(&fakeClock{}))
+	if err != nil || result != 42 {
+		t.Errorf("Expected (42, nil), got (%d, %v)", result, err)
+	}
+
+	_, err = Retry(context.Background(), func(ctx context.Context) (int, error) {
+		panic("always")
+	}, WithRetryClock(&fakeClock{}))
+
+	var pe *PanicError
+	if !errors.As(err, &pe) {
+		t.Errorf("Expected error wrapping *PanicError, got %v", err)
+	}
+}
+
+func TestRetry_Context(t *testing.T) {
+	// Test that a done context stops retries
+	t.Run("already_done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		f, calls := failingN(0, nil, "ok")
+
+		_, err := Retry(ctx, f, WithRetryClock(&fakeClock{}))
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+		if *calls != 0 {
+			t.Errorf("Expected no attempts, got %d", *calls)
+		}
+	})
+
+	t.Run("cancelled_while_sleeping", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		transient := errors.New("transient")
+		f := func(ctx context.Context) (int, error) {
+			cancel()
+			return 0, transient
+		}
+
+		_, err := Retry(ctx, f, WithRetryClock(&fakeClock{}))
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+		if !errors.Is(err, transient) {
+			t.Errorf("Expected error to also wrap %v, got %v", transient, err)
+		}
+	})
+
+	t.Run("real_clock", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		f, _ := failingN(100, errors.New("transient"), "ok")
+
+		_, err := Retry(ctx, f, WithBackoff(time.Hour, time.Hour, 2))
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+		}
+	})
+}
+
+// Benchmark tests
+func BenchmarkRetry_Success(b *testing.B) {
+	ctx := context.Background()
+	f := func(ctx context.Context) (int, error) { return 42, nil }
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Retry(ctx, f)
+	}
+}
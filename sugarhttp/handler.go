@@ -0,0 +1,140 @@
+package sugarhttp
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"runtime"
+
+	"github.com/dccarswell/sugar"
+)
+
+// StatusCoder is implemented by errors that carry the HTTP status code they
+// should be reported with. Handle finds it anywhere in an error's chain with
+// errors.As.
+//
+// Example usage:
+//
+//	type notFound struct{ id string }
+//
+//	func (e notFound) Error() string   { return "item " + e.id + " not found" }
+//	func (e notFound) StatusCode() int { return http.StatusNotFound }
+type StatusCoder interface {
+	StatusCode() int
+}
+
+// HandlerFunc is an HTTP handler that reports failure by returning an error,
+// by calling sugar.Check or by panicking with an error through sugar.Must.
+// Use Handle to serve it with options; a HandlerFunc is also an http.Handler
+// itself, using the default options.
+type HandlerFunc func(w http.ResponseWriter, r *http.Request) error
+
+// ServeHTTP calls f as Handle(f).ServeHTTP would.
+func (f HandlerFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	o := newOptions(nil)
+	o.serve(f, w, r)
+}
+
+// Handle adapts f to an http.Handler, so that request scopes can use Must and
+// Check freely. Every failure of f becomes an application/problem+json
+// response:
+//   - an error returned by f, raised with sugar.Check or thrown with
+//     sugar.Must is reported with the status code of the first StatusCoder in
+//     its chain, or 500 if there is none. For 4xx statuses the error message
+//     is sent as the problem detail; 5xx errors are logged and their message
+//     is kept from the client.
+//   - a genuine panic, such as a runtime error or a panic with a non-error
+//     value, is handled like Recover: it is logged with its stack and becomes
+//     a 500 response.
+//
+// If f had already started writing the response, the failure is only logged.
+// A panic with http.ErrAbortHandler is re-raised unchanged.
+//
+// Example usage:
+//
+//	mux.Handle("GET /items/{id}", sugarhttp.Handle(func(w http.ResponseWriter, r *http.Request) error {
+//	    item := sugar.Must(store.Get(r.Context(), r.PathValue("id")))
+//	    return json.NewEncoder(w).Encode(item)
+//	}, sugarhttp.WithLogger(logger)))
+func Handle(f HandlerFunc, opts ...Option) http.Handler {
+	o := newOptions(opts)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		o.serve(f, w, r)
+	})
+}
+
+func (o *options) serve(f HandlerFunc, w http.ResponseWriter, r *http.Request) {
+	tw := newTrackingWriter(w)
+	var err error
+	perr := sugar.Try0(func() {
+		err = callChecked(f, tw.writer(), r)
+	})
+
+	var pe *sugar.PanicError
+	if errors.As(perr, &pe) {
+		if pe.Value == http.ErrAbortHandler {
+			panic(http.ErrAbortHandler)
+		}
+		if isGenuinePanic(pe) {
+			o.logPanic(r, pe)
+			if !tw.wroteHeader {
+				tw.writeFailure(NewProblem(http.StatusInternalServerError))
+			}
+			return
+		}
+		err = pe.Unwrap()
+	}
+	if err == nil {
+		return
+	}
+
+	status := statusOf(err)
+	if status >= 500 || tw.wroteHeader {
+		o.logger.ErrorContext(r.Context(), "handler error",
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.Path),
+			slog.Int("status", status),
+			slog.Any("error", err),
+		)
+	}
+	if tw.wroteHeader {
+		return
+	}
+	p := NewProblem(status)
+	if status < 500 {
+		p.Detail = err.Error()
+	}
+	tw.writeFailure(p)
+}
+
+// callChecked calls f, turning an error raised by sugar.Check into its
+// result. Every other panic continues to unwind.
+func callChecked(f HandlerFunc, w http.ResponseWriter, r *http.Request) (err error) {
+	defer sugar.Catch(&err)
+	return f(w, r)
+}
+
+// isGenuinePanic reports whether pe holds a bug rather than an error thrown
+// on purpose: a runtime error, or a value that is not an error at all.
+func isGenuinePanic(pe *sugar.PanicError) bool {
+	switch pe.Value.(type) {
+	case runtime.Error:
+		return true
+	case error:
+		return false
+	default:
+		return true
+	}
+}
+
+// statusOf returns the status code of the first StatusCoder in err's chain,
+// or 500 if there is none or its code is not an error status.
+func statusOf(err error) int {
+	var sc StatusCoder
+	if errors.As(err, &sc) {
+		if code := sc.StatusCode(); code >= 400 && code <= 599 {
+			return code
+		}
+	}
+	return http.StatusInternalServerError
+}
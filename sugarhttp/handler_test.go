@@ -0,0 +1,238 @@
+package sugarhttp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/dccarswell/sugar"
+)
+
+type testStatusError struct {
+	code int
+	msg  string
+}
+
+func (e *testStatusError) Error() string   { return e.msg }
+func (e *testStatusError) StatusCode() int { return e.code }
+
+func serveProblem(t *testing.T, h http.Handler) (*httptest.ResponseRecorder, Problem) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/items/42", nil))
+
+	var p Problem
+	if ct := rec.Header().Get("Content-Type"); ct == ProblemContentType {
+		if err := json.Unmarshal(rec.Body.Bytes(), &p); err != nil {
+			t.Fatalf("Expected JSON body, got %q: %v", rec.Body.String(), err)
+		}
+	}
+	return rec, p
+}
+
+func TestHandle_Success(t *testing.T) {
+	// Test that a handler returning nil writes its own response
+	logger, logs := newTestLogger()
+	h := Handle(func(w http.ResponseWriter, r *http.Request) error {
+		w.Write([]byte("ok"))
+		return nil
+	}, WithLogger(logger))
+
+	rec, _ := serveProblem(t, h)
+
+	if rec.Code != http.StatusOK || rec.Body.String() != "ok" {
+		t.Errorf("Expected 200 %q, got %d %q", "ok", rec.Code, rec.Body.String())
+	}
+	if logs.Len() != 0 {
+		t.Errorf("Expected nothing logged, got %s", logs)
+	}
+}
+
+func TestHandle_Errors(t *testing.T) {
+	// Test that returned, checked and thrown errors become problem responses
+	notFound := &testStatusError{code: http.StatusNotFound, msg: "item 42 not found"}
+
+	tests := []struct {
+		name   string
+		f      HandlerFunc
+		status int
+		detail string
+		logged bool
+	}{
+		{"returned_status_error", func(w http.ResponseWriter, r *http.Request) error {
+			return notFound
+		}, 404, "item 42 not found", false},
+		{"wrapped_status_error", func(w http.ResponseWriter, r *http.Request) error {
+			return fmt.Errorf("loading: %w", notFound)
+		}, 404, "loading: item 42 not found", false},
+		{"must_status_error", func(w http.ResponseWriter, r *http.Request) error {
+			sugar.Must0(notFound)
+			return nil
+		}, 404, "item 42 not found", false},
+		{"check_status_error", func(w http.ResponseWriter, r *http.Request) error {
+			sugar.Check(notFound)
+			return nil
+		}, 404, "item 42 not found", false},
+		{"plain_error", func(w http.ResponseWriter, r *http.Request) error {
+			return io.ErrUnexpectedEOF
+		}, 500, "", true},
+		{"must_plain_error", func(w http.ResponseWriter, r *http.Request) error {
+			sugar.Must(io.ReadAll(iotest.ErrReader(io.ErrUnexpectedEOF)))
+			return nil
+		}, 500, "", true},
+		{"invalid_status_code", func(w http.ResponseWriter, r *http.Request) error {
+			return &testStatusError{code: http.StatusOK, msg: "not an error status"}
+		}, 500, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, logs := newTestLogger()
+			rec, p := serveProblem(t, Handle(tt.f, WithLogger(logger)))
+
+			if rec.Code != tt.status {
+				t.Errorf("Expected status %d, got %d", tt.status, rec.Code)
+			}
+			if p.Status != tt.status || p.Detail != tt.detail {
+				t.Errorf("Expected problem status %d detail %q, got %+v", tt.status, tt.detail, p)
+			}
+			if (logs.Len() != 0) != tt.logged {
+				t.Errorf("Expected logged=%t, got %q", tt.logged, logs.String())
+			}
+			if strings.Contains(logs.String(), `"stack"`) {
+				t.Errorf("Expected no stack logged for an error, got %s", logs)
+			}
+		})
+	}
+}
+
+func TestHandle_GenuinePanic(t *testing.T) {
+	// Test that runtime panics and non-error panics still become 500 with stack
+	tests := []struct {
+		name string
+		f    HandlerFunc
+	}{
+		{"nil_dereference", func(w http.ResponseWriter, r *http.Request) error {
+			var p *testStatusError
+			return errors.New(p.msg)
+		}},
+		{"string_panic", func(w http.ResponseWriter, r *http.Request) error {
+			panic("boom")
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, logs := newTestLogger()
+			rec, p := serveProblem(t, Handle(tt.f, WithLogger(logger)))
+
+			if rec.Code != http.StatusInternalServerError || p.Status != http.StatusInternalServerError {
+				t.Errorf("Expected status 500, got %d (%+v)", rec.Code, p)
+			}
+			if p.Detail != "" {
+				t.Errorf("Expected no detail, got %q", p.Detail)
+			}
+			if !strings.Contains(logs.String(), `"stack"`) {
+				t.Errorf("Expected stack logged, got %s", logs)
+			}
+		})
+	}
+}
+
+func TestHandle_DiscardsHandlerHeaders(t *testing.T) {
+	// Test that only headers set by the failing handler are discarded
+	tests := []struct {
+		name string
+		fail func() error
+	}{
+		{"returned_error", func() error {
+			return &testStatusError{code: http.StatusBadRequest, msg: "bad"}
+		}},
+		{"runtime_panic", func() error {
+			var m map[string]int
+			m["key"] = 1
+			return nil
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := newTestLogger()
+			h := withOuterHeaders(Handle(func(w http.ResponseWriter, r *http.Request) error {
+				w.Header().Set("Content-Length", "5")
+				w.Header().Set("Content-Encoding", "gzip")
+				return tt.fail()
+			}, WithLogger(logger)))
+
+			rec, _ := serveProblem(t, h)
+
+			for _, name := range []string{"Content-Length", "Content-Encoding"} {
+				if v := rec.Header().Get(name); v != "" {
+					t.Errorf("Expected %s to be discarded, got %q", name, v)
+				}
+			}
+			if v := rec.Header().Get("Access-Control-Allow-Origin"); v != "*" {
+				t.Errorf("Expected outer CORS header to be kept, got %q", v)
+			}
+			if v := rec.Header().Get("X-Request-Id"); v != "req-1" {
+				t.Errorf("Expected outer X-Request-Id %q to be kept, got %q", "req-1", v)
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != ProblemContentType {
+				t.Errorf("Expected Content-Type %q, got %q", ProblemContentType, ct)
+			}
+		})
+	}
+}
+
+func TestHandle_HeadersAlreadySent(t *testing.T) {
+	// Test that a late error is logged but not written
+	logger, logs := newTestLogger()
+	h := Handle(func(w http.ResponseWriter, r *http.Request) error {
+		w.Write([]byte("partial"))
+		return &testStatusError{code: http.StatusBadRequest, msg: "late"}
+	}, WithLogger(logger))
+
+	rec, _ := serveProblem(t, h)
+
+	if rec.Code != http.StatusOK || rec.Body.String() != "partial" {
+		t.Errorf("Expected 200 %q, got %d %q", "partial", rec.Code, rec.Body.String())
+	}
+	if !strings.Contains(logs.String(), "late") {
+		t.Errorf("Expected error logged, got %s", logs)
+	}
+}
+
+func TestHandle_ErrAbortHandler(t *testing.T) {
+	// Test that http.ErrAbortHandler is re-raised unchanged
+	h := Handle(func(w http.ResponseWriter, r *http.Request) error {
+		panic(http.ErrAbortHandler)
+	})
+
+	defer func() {
+		if r := recover(); r != http.ErrAbortHandler {
+			t.Errorf("Expected panic with http.ErrAbortHandler, got %v", r)
+		}
+	}()
+
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	t.Error("Expected handler to panic, but it didn't")
+}
+
+func TestHandlerFunc_ServeHTTP(t *testing.T) {
+	// Test that a HandlerFunc is usable directly as an http.Handler
+	var h http.Handler = HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		return &testStatusError{code: http.StatusConflict, msg: "already exists"}
+	})
+
+	rec, p := serveProblem(t, h)
+
+	if rec.Code != http.StatusConflict || p.Detail != "already exists" {
+		t.Errorf("Expected 409 %q, got %d %+v", "already exists", rec.Code, p)
+	}
+}
@@ -0,0 +1,54 @@
+package sugarhttp
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// ProblemContentType is the media type of RFC 9457 problem details.
+const ProblemContentType = "application/problem+json"
+
+// Problem is an RFC 9457 problem details object.
+type Problem struct {
+	// Type is a URI reference identifying the problem type. When empty it is
+	// written as "about:blank", meaning the problem has no semantics beyond
+	// its HTTP status code.
+	Type string `json:"type,omitempty"`
+
+	// Title is a short, human-readable summary of the problem type.
+	Title string `json:"title,omitempty"`
+
+	// Status is the HTTP status code of the response.
+	Status int `json:"status,omitempty"`
+
+	// Detail is a human-readable explanation specific to this occurrence.
+	Detail string `json:"detail,omitempty"`
+
+	// Instance is a URI reference identifying this occurrence.
+	Instance string `json:"instance,omitempty"`
+}
+
+// NewProblem returns a Problem for status, with the standard status text as
+// its title.
+func NewProblem(status int) Problem {
+	return Problem{Type: "about:blank", Title: http.StatusText(status), Status: status}
+}
+
+// WriteProblem writes p as an application/problem+json response with p.Status
+// as the status code, or 500 if p.Status is zero.
+func WriteProblem(w http.ResponseWriter, p Problem) {
+	if p.Status == 0 {
+		p.Status = http.StatusInternalServerError
+	}
+	if p.Type == "" {
+		p.Type = "about:blank"
+	}
+	if p.Title == "" && p.Type == "about:blank" {
+		p.Title = http.StatusText(p.Status)
+	}
+
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(p.Status)
+	json.NewEncoder(w).Encode(p)
+}
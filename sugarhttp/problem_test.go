@@ -0,0 +1,27 @@
+package sugarhttp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWriteProblem(t *testing.T) {
+	// Test that problems are written with defaults filled in
+	rec := httptest.NewRecorder()
+	WriteProblem(rec, Problem{Status: http.StatusNotFound, Detail: "no such item"})
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", rec.Code)
+	}
+
+	var p Problem
+	if err := json.Unmarshal(rec.Body.Bytes(), &p); err != nil {
+		t.Fatalf("Expected JSON body, got %q: %v", rec.Body.String(), err)
+	}
+	expected := Problem{Type: "about:blank", Title: "Not Found", Status: 404, Detail: "no such item"}
+	if p != expected {
+		t.Errorf("Expected problem %+v, got %+v", expected, p)
+	}
+}
@@ -0,0 +1,215 @@
+// Package sugarhttp adapts the sugar package to net/http: panic-recovery
+// middleware that renders RFC 9457 problem details, built on sugar.Try.
+package sugarhttp
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"log/slog"
+	"maps"
+	"net"
+	"net/http"
+
+	"github.com/dccarswell/sugar"
+)
+
+// Option configures Recover.
+type Option func(*options)
+
+type options struct {
+	logger *slog.Logger
+}
+
+func newOptions(opts []Option) options {
+	o := options{logger: slog.Default()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithLogger sets the logger used to report recovered panics. The default is
+// slog.Default().
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// Recover wraps next so that every request runs under sugar.Try-style
+// recovery. When a handler panics, Recover:
+//   - logs the panic value, its stack and the request method and path at
+//     error level
+//   - responds with a 500 application/problem+json body, unless the handler
+//     had already started writing the response, in which case nothing more
+//     can be sent
+//
+// A panic with http.ErrAbortHandler is re-raised unchanged, so that net/http
+// aborts the response silently as documented.
+//
+// Example usage:
+//
+//	mux := http.NewServeMux()
+//	mux.HandleFunc("/", index)
+//	http.ListenAndServe(":8080", sugarhttp.Recover(mux, sugarhttp.WithLogger(logger)))
+func Recover(next http.Handler, opts ...Option) http.Handler {
+	o := newOptions(opts)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tw := newTrackingWriter(w)
+		err := sugar.Try0(func() {
+			next.ServeHTTP(tw.writer(), r)
+		})
+
+		var pe *sugar.PanicError
+		if !errors.As(err, &pe) {
+			return
+		}
+		if pe.Value == http.ErrAbortHandler {
+			panic(http.ErrAbortHandler)
+		}
+		o.logPanic(r, pe)
+		if !tw.wroteHeader {
+			tw.writeFailure(NewProblem(http.StatusInternalServerError))
+		}
+	})
+}
+
+func (o *options) logPanic(r *http.Request, pe *sugar.PanicError) {
+	o.logger.ErrorContext(r.Context(), "panic recovered",
+		slog.String("method", r.Method),
+		slog.String("path", r.URL.Path),
+		slog.Any("panic", pe),
+	)
+}
+
+// trackingWriter records whether the response header has been written, so
+// that a panic after the response has started does not try to send another.
+// Handlers are given the writer returned by its writer method, which has the
+// same optional interfaces as the writer it wraps.
+type trackingWriter struct {
+	http.ResponseWriter
+	wroteHeader bool
+
+	// header is a copy of the header map taken before the handler ran.
+	header http.Header
+}
+
+func newTrackingWriter(w http.ResponseWriter) *trackingWriter {
+	return &trackingWriter{ResponseWriter: w, header: w.Header().Clone()}
+}
+
+// writeFailure writes p in place of the response a failed handler did not
+// get to send. Headers the handler had set, such as Content-Length or
+// Content-Encoding, describe that response rather than p, so the header map
+// is first restored to its state before the handler ran. Headers set by outer
+// middleware, such as CORS headers, are kept.
+func (w *trackingWriter) writeFailure(p Problem) {
+	h := w.ResponseWriter.Header()
+	clear(h)
+	maps.Copy(h, w.header)
+	WriteProblem(w.ResponseWriter, p)
+}
+
+func (w *trackingWriter) WriteHeader(code int) {
+	// 1xx informational responses may be followed by the final header.
+	if code >= 200 {
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *trackingWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.ResponseWriter.Write(b)
+}
+
+// writer returns w as the http.ResponseWriter to pass to the handler. Of the
+// optional http.Flusher, http.Hijacker and io.ReaderFrom interfaces, it
+// implements exactly those the wrapped writer implements, so that handlers
+// asserting them keep working behind the middleware, and still fall back
+// when the wrapped writer lacks them.
+func (w *trackingWriter) writer() http.ResponseWriter {
+	_, canFlush := w.ResponseWriter.(http.Flusher)
+	_, canHijack := w.ResponseWriter.(http.Hijacker)
+	_, canReadFrom := w.ResponseWriter.(io.ReaderFrom)
+	f, h, rf := trackingFlusher{w}, trackingHijacker{w}, trackingReaderFrom{w}
+
+	switch {
+	case canFlush && canHijack && canReadFrom:
+		return struct {
+			*trackingWriter
+			http.Flusher
+			http.Hijacker
+			io.ReaderFrom
+		}{w, f, h, rf}
+	case canFlush && canHijack:
+		return struct {
+			*trackingWriter
+			http.Flusher
+			http.Hijacker
+		}{w, f, h}
+	case canFlush && canReadFrom:
+		return struct {
+			*trackingWriter
+			http.Flusher
+			io.ReaderFrom
+		}{w, f, rf}
+	case canHijack && canReadFrom:
+		return struct {
+			*trackingWriter
+			http.Hijacker
+			io.ReaderFrom
+		}{w, h, rf}
+	case canFlush:
+		return struct {
+			*trackingWriter
+			http.Flusher
+		}{w, f}
+	case canHijack:
+		return struct {
+			*trackingWriter
+			http.Hijacker
+		}{w, h}
+	case canReadFrom:
+		return struct {
+			*trackingWriter
+			io.ReaderFrom
+		}{w, rf}
+	default:
+		return w
+	}
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer, for
+// example to set deadlines.
+func (w *trackingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// trackingFlusher, trackingHijacker and trackingReaderFrom implement the
+// optional interfaces of a trackingWriter whose wrapped writer supports them.
+
+type trackingFlusher struct{ w *trackingWriter }
+
+func (f trackingFlusher) Flush() {
+	f.w.wroteHeader = true
+	f.w.ResponseWriter.(http.Flusher).Flush()
+}
+
+type trackingHijacker struct{ w *trackingWriter }
+
+func (h trackingHijacker) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, rw, err := h.w.ResponseWriter.(http.Hijacker).Hijack()
+	if err == nil {
+		h.w.wroteHeader = true
+	}
+	return conn, rw, err
+}
+
+type trackingReaderFrom struct{ w *trackingWriter }
+
+func (rf trackingReaderFrom) ReadFrom(src io.Reader) (int64, error) {
+	rf.w.wroteHeader = true
+	return rf.w.ResponseWriter.(io.ReaderFrom).ReadFrom(src)
+}
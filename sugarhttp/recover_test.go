@@ -0,0 +1,293 @@
+package sugarhttp
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newTestLogger() (*slog.Logger, *bytes.Buffer) {
+	var buf bytes.Buffer
+	return slog.New(slog.NewJSONHandler(&buf, nil)), &buf
+}
+
+func TestRecover_NoPanic(t *testing.T) {
+	// Test that requests without panics pass through unchanged
+	logger, logs := newTestLogger()
+	h := Recover(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte("created"))
+	}), WithLogger(logger))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("POST", "/items", nil))
+
+	if rec.Code != http.StatusCreated {
+		t.Errorf("Expected status 201, got %d", rec.Code)
+	}
+	if rec.Body.String() != "created" {
+		t.Errorf("Expected body %q, got %q", "created", rec.Body.String())
+	}
+	if logs.Len() != 0 {
+		t.Errorf("Expected nothing logged, got %s", logs)
+	}
+}
+
+func TestRecover_Panic(t *testing.T) {
+	// Test that a panic becomes a problem+json 500 and is logged
+	logger, logs := newTestLogger()
+	h := Recover(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}), WithLogger(logger))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/items/42", nil))
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("Expected status 500, got %d", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != ProblemContentType {
+		t.Errorf("Expected Content-Type %q, got %q", ProblemContentType, ct)
+	}
+
+	var p Problem
+	if err := json.Unmarshal(rec.Body.Bytes(), &p); err != nil {
+		t.Fatalf("Expected JSON body, got %q: %v", rec.Body.String(), err)
+	}
+	expected := Problem{Type: "about:blank", Title: "Internal Server Error", Status: 500}
+	if p != expected {
+		t.Errorf("Expected problem %+v, got %+v", expected, p)
+	}
+	if strings.Contains(rec.Body.String(), "boom") {
+		t.Errorf("Expected panic value not to leak into the response, got %q", rec.Body.String())
+	}
+
+	var entry struct {
+		Method string
+		Path   string
+		Panic  struct {
+			Value string
+			Type  string
+			Stack string
+		}
+	}
+	if err := json.Unmarshal(logs.Bytes(), &entry); err != nil {
+		t.Fatalf("Expected one JSON log entry, got %q: %v", logs.String(), err)
+	}
+	if entry.Method != "GET" || entry.Path != "/items/42" {
+		t.Errorf("Expected method and path in log, got %+v", entry)
+	}
+	if entry.Panic.Value != "boom" || entry.Panic.Type != "string" {
+		t.Errorf("Expected panic value and type in log, got %+v", entry.Panic)
+	}
+	if !strings.Contains(entry.Panic.Stack, "goroutine") {
+		t.Errorf("Expected stack in log, got %q", entry.Panic.Stack)
+	}
+}
+
+// withOuterHeaders wraps h like an outer middleware setting response headers
+// before the request reaches h.
+func withOuterHeaders(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("X-Request-Id", "req-1")
+		h.ServeHTTP(w, r)
+	})
+}
+
+func TestRecover_DiscardsHandlerHeaders(t *testing.T) {
+	// Test that only headers set by the panicking handler are discarded
+	logger, _ := newTestLogger()
+	h := withOuterHeaders(Recover(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "5")
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Header().Set("X-Request-Id", "overwritten")
+		panic("boom")
+	}), WithLogger(logger)))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("Expected status 500, got %d", rec.Code)
+	}
+	for _, name := range []string{"Content-Length", "Content-Encoding"} {
+		if v := rec.Header().Get(name); v != "" {
+			t.Errorf("Expected %s to be discarded, got %q", name, v)
+		}
+	}
+	if v := rec.Header().Get("Access-Control-Allow-Origin"); v != "*" {
+		t.Errorf("Expected outer CORS header to be kept, got %q", v)
+	}
+	if v := rec.Header().Get("X-Request-Id"); v != "req-1" {
+		t.Errorf("Expected outer X-Request-Id %q to be restored, got %q", "req-1", v)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != ProblemContentType {
+		t.Errorf("Expected Content-Type %q, got %q", ProblemContentType, ct)
+	}
+}
+
+func TestRecover_HeadersAlreadySent(t *testing.T) {
+	// Test that nothing more is written once the response has started
+	logger, logs := newTestLogger()
+	h := Recover(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("partial"))
+		panic("boom")
+	}), WithLogger(logger))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected original status 200, got %d", rec.Code)
+	}
+	if rec.Body.String() != "partial" {
+		t.Errorf("Expected body %q, got %q", "partial", rec.Body.String())
+	}
+	if logs.Len() == 0 {
+		t.Error("Expected panic to be logged")
+	}
+}
+
+func TestRecover_ErrAbortHandler(t *testing.T) {
+	// Test that http.ErrAbortHandler is re-raised unchanged
+	logger, logs := newTestLogger()
+	h := Recover(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic(http.ErrAbortHandler)
+	}), WithLogger(logger))
+
+	defer func() {
+		if r := recover(); r != http.ErrAbortHandler {
+			t.Errorf("Expected panic with http.ErrAbortHandler, got %v", r)
+		}
+		if logs.Len() != 0 {
+			t.Errorf("Expected nothing logged, got %s", logs)
+		}
+	}()
+
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	t.Error("Expected handler to panic, but it didn't")
+}
+
+func TestRecover_ResponseController(t *testing.T) {
+	// Test that the wrapped writer still supports http.ResponseController
+	h := Recover(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := http.NewResponseController(w).Flush(); err != nil {
+			t.Errorf("Expected Flush to be supported, got %v", err)
+		}
+	}))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+
+	if !rec.Flushed {
+		t.Error("Expected response to be flushed")
+	}
+}
+
+// plainWriter hides every optional interface of the writer it wraps.
+type plainWriter struct {
+	http.ResponseWriter
+}
+
+func TestRecover_OptionalInterfaces(t *testing.T) {
+	// Test that the wrapper has exactly the optional interfaces of the writer it wraps
+	t.Run("recorder", func(t *testing.T) {
+		h := Recover(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			f, ok := w.(http.Flusher)
+			if !ok {
+				t.Fatal("Expected writer to implement http.Flusher")
+			}
+			if _, ok := w.(http.Hijacker); ok {
+				t.Error("Expected writer not to implement http.Hijacker")
+			}
+			if _, ok := w.(io.ReaderFrom); ok {
+				t.Error("Expected writer not to implement io.ReaderFrom")
+			}
+			if _, _, err := http.NewResponseController(w).Hijack(); !errors.Is(err, http.ErrNotSupported) {
+				t.Errorf("Expected http.ErrNotSupported from Hijack, got %v", err)
+			}
+			f.Flush()
+		}))
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+
+		if !rec.Flushed {
+			t.Error("Expected response to be flushed")
+		}
+	})
+
+	t.Run("plain_writer", func(t *testing.T) {
+		h := Recover(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, ok := w.(http.Flusher); ok {
+				t.Error("Expected writer not to implement http.Flusher")
+			}
+			if err := http.NewResponseController(w).Flush(); !errors.Is(err, http.ErrNotSupported) {
+				t.Errorf("Expected http.ErrNotSupported from Flush, got %v", err)
+			}
+		}))
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(plainWriter{rec}, httptest.NewRequest("GET", "/", nil))
+
+		if rec.Flushed {
+			t.Error("Expected response not to be flushed")
+		}
+	})
+
+	t.Run("server_writer", func(t *testing.T) {
+		logger, _ := newTestLogger()
+		srv := httptest.NewServer(Recover(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, canFlush := w.(http.Flusher)
+			_, canHijack := w.(http.Hijacker)
+			_, canReadFrom := w.(io.ReaderFrom)
+			if !canFlush || !canHijack || !canReadFrom {
+				t.Errorf("Expected Flusher, Hijacker and ReaderFrom, got %t, %t, %t", canFlush, canHijack, canReadFrom)
+			}
+			io.Copy(w, strings.NewReader("copied"))
+			panic("boom")
+		}), WithLogger(logger)))
+		defer srv.Close()
+
+		resp, err := http.Get(srv.URL)
+		if err != nil {
+			t.Fatalf("Expected request to succeed, got %v", err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		if resp.StatusCode != http.StatusOK || string(body) != "copied" {
+			t.Errorf("Expected 200 %q, got %d %q", "copied", resp.StatusCode, body)
+		}
+	})
+
+	t.Run("hijack", func(t *testing.T) {
+		srv := httptest.NewServer(Recover(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			conn, rw, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Errorf("Expected Hijack to succeed, got %v", err)
+				return
+			}
+			defer conn.Close()
+			rw.WriteString("HTTP/1.1 200 OK\r\nContent-Length: 8\r\nConnection: close\r\n\r\nhijacked")
+			rw.Flush()
+		})))
+		defer srv.Close()
+
+		resp, err := http.Get(srv.URL)
+		if err != nil {
+			t.Fatalf("Expected request to succeed, got %v", err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		if string(body) != "hijacked" {
+			t.Errorf("Expected body %q, got %q", "hijacked", body)
+		}
+	})
+}
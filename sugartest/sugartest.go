@@ -0,0 +1,123 @@
+// Package sugartest provides test assertions for panics and errors, built on
+// the sugar package. It replaces the hand-rolled
+//
+//	defer func() {
+//		if r := recover(); r == nil {
+//			t.Error("Expected function to panic, but it didn't")
+//		}
+//	}()
+//
+// blocks that otherwise appear in every test exercising Must, Handle or Check.
+// All helpers call t.Helper, so failures are reported at the caller's line.
+package sugartest
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/dccarswell/sugar"
+)
+
+// recoverPanic runs f and returns the recovered panic, or nil if f returned
+// normally.
+func recoverPanic(f func()) *sugar.PanicError {
+	var pe *sugar.PanicError
+	errors.As(sugar.Try0(f), &pe)
+	return pe
+}
+
+// AssertPanics fails the test if f does not panic. It returns the recovered
+// panic value for further checks.
+//
+// Example usage:
+//
+//	sugartest.AssertPanics(t, func() {
+//		sugar.Must(strconv.Atoi("x"))
+//	})
+func AssertPanics(t testing.TB, f func()) any {
+	t.Helper()
+	pe := recoverPanic(f)
+	if pe == nil {
+		t.Errorf("Expected function to panic, but it didn't")
+		return nil
+	}
+	return pe.Value
+}
+
+// AssertPanicsWith fails the test if f does not panic, or if the panic value
+// is not an error matching target according to errors.Is.
+//
+// Example usage:
+//
+//	sugartest.AssertPanicsWith(t, func() {
+//		sugar.Must(os.ReadFile("missing.txt"))
+//	}, fs.ErrNotExist)
+func AssertPanicsWith(t testing.TB, f func(), target error) {
+	t.Helper()
+	pe := recoverPanic(f)
+	if pe == nil {
+		t.Errorf("Expected function to panic with %v, but it didn't", target)
+		return
+	}
+	err, ok := pe.Value.(error)
+	if !ok {
+		t.Errorf("Expected panic with error %v, got non-error %v (%T)", target, pe.Value, pe.Value)
+		return
+	}
+	if !errors.Is(err, target) {
+		t.Errorf("Expected panic with %v, got %v", target, err)
+	}
+}
+
+// AssertPanicValue fails the test if f does not panic, or if the panic value
+// is not deeply equal to want.
+//
+// Example usage:
+//
+//	sugartest.AssertPanicValue(t, func() { panic("boom") }, "boom")
+func AssertPanicValue(t testing.TB, f func(), want any) {
+	t.Helper()
+	pe := recoverPanic(f)
+	if pe == nil {
+		t.Errorf("Expected function to panic with %v, but it didn't", want)
+		return
+	}
+	if !reflect.DeepEqual(pe.Value, want) {
+		t.Errorf("Expected panic with %v (%T), got %v (%T)", want, want, pe.Value, pe.Value)
+	}
+}
+
+// AssertNoPanic fails the test if f panics, reporting the panic value and the
+// stack of the panicking goroutine.
+//
+// Example usage:
+//
+//	sugartest.AssertNoPanic(t, func() {
+//		handler("value", nil)
+//	})
+func AssertNoPanic(t testing.TB, f func()) {
+	t.Helper()
+	if pe := recoverPanic(f); pe != nil {
+		t.Errorf("Expected no panic, got %v\n%s", pe.Value, pe.Stack)
+	}
+}
+
+// MustT returns a test-aware counterpart of sugar.Must. Instead of panicking,
+// the returned function stops the test with t.Fatalf, reported at the line
+// that called it, which keeps test setup concise without turning a setup
+// failure into a panic with an unrelated stack.
+//
+// Example usage:
+//
+//	must := sugartest.MustT[[]byte](t)
+//	data := must(os.ReadFile("testdata/input.json"))
+func MustT[T any](t testing.TB) func(T, error) T {
+	return func(v T, err error) T {
+		t.Helper()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return v
+	}
+}
@@ -0,0 +1,167 @@
+package sugartest
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/dccarswell/sugar"
+)
+
+// fakeTB records failures instead of reporting them, so that the assertions
+// themselves can be tested.
+type fakeTB struct {
+	testing.TB
+	errors []string
+	fatal  bool
+}
+
+func (f *fakeTB) Helper() {}
+
+func (f *fakeTB) Errorf(format string, args ...any) {
+	f.errors = append(f.errors, fmt.Sprintf(format, args...))
+}
+
+func (f *fakeTB) Fatalf(format string, args ...any) {
+	f.errors = append(f.errors, fmt.Sprintf(format, args...))
+	f.fatal = true
+	runtime.Goexit()
+}
+
+// run calls f with a fresh fakeTB on its own goroutine, so that Fatalf can
+// stop it like the testing package does.
+func run(f func(tb *fakeTB)) *fakeTB {
+	tb := &fakeTB{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f(tb)
+	}()
+	<-done
+	return tb
+}
+
+func TestAssertPanics(t *testing.T) {
+	// Test that AssertPanics passes on panics and fails otherwise
+	var value any
+	tb := run(func(tb *fakeTB) {
+		value = AssertPanics(tb, func() { panic("boom") })
+	})
+	if len(tb.errors) != 0 {
+		t.Errorf("Expected no failures, got %q", tb.errors)
+	}
+	if value != "boom" {
+		t.Errorf("Expected panic value boom, got %v", value)
+	}
+
+	tb = run(func(tb *fakeTB) {
+		AssertPanics(tb, func() {})
+	})
+	if len(tb.errors) != 1 {
+		t.Errorf("Expected one failure, got %q", tb.errors)
+	}
+}
+
+func TestAssertPanicsWith(t *testing.T) {
+	// Test that AssertPanicsWith matches the panic error with errors.Is
+	tests := []struct {
+		name     string
+		f        func()
+		failures int
+	}{
+		{"matching", func() { panic(io.EOF) }, 0},
+		{"wrapped", func() { panic(fmt.Errorf("reading: %w", io.EOF)) }, 0},
+		{"check", func() { sugar.Check(io.EOF) }, 0},
+		{"must", func() { sugar.Must0(io.EOF) }, 0},
+		{"other_error", func() { panic(io.ErrClosedPipe) }, 1},
+		{"non_error", func() { panic("EOF") }, 1},
+		{"no_panic", func() {}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tb := run(func(tb *fakeTB) {
+				AssertPanicsWith(tb, tt.f, io.EOF)
+			})
+			if len(tb.errors) != tt.failures {
+				t.Errorf("Expected %d failures, got %q", tt.failures, tb.errors)
+			}
+		})
+	}
+}
+
+func TestAssertPanicValue(t *testing.T) {
+	// Test that AssertPanicValue compares panic values deeply
+	tests := []struct {
+		name     string
+		f        func()
+		want     any
+		failures int
+	}{
+		{"equal_string", func() { panic("boom") }, "boom", 0},
+		{"equal_slice", func() { panic([]int{1, 2}) }, []int{1, 2}, 0},
+		{"different_value", func() { panic("boom") }, "bang", 1},
+		{"different_type", func() { panic(1) }, int64(1), 1},
+		{"no_panic", func() {}, "boom", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tb := run(func(tb *fakeTB) {
+				AssertPanicValue(tb, tt.f, tt.want)
+			})
+			if len(tb.errors) != tt.failures {
+				t.Errorf("Expected %d failures, got %q", tt.failures, tb.errors)
+			}
+		})
+	}
+}
+
+func TestAssertNoPanic(t *testing.T) {
+	// Test that AssertNoPanic reports the panic value and stack
+	tb := run(func(tb *fakeTB) {
+		AssertNoPanic(tb, func() {})
+	})
+	if len(tb.errors) != 0 {
+		t.Errorf("Expected no failures, got %q", tb.errors)
+	}
+
+	tb = run(func(tb *fakeTB) {
+		AssertNoPanic(tb, func() { panic("boom") })
+	})
+	if len(tb.errors) != 1 {
+		t.Fatalf("Expected one failure, got %q", tb.errors)
+	}
+	if !strings.Contains(tb.errors[0], "boom") || !strings.Contains(tb.errors[0], "goroutine") {
+		t.Errorf("Expected failure to contain panic value and stack, got %q", tb.errors[0])
+	}
+}
+
+func TestMustT(t *testing.T) {
+	// Test that MustT returns values and stops the test on errors
+	var result int
+	tb := run(func(tb *fakeTB) {
+		result = MustT[int](tb)(42, nil)
+	})
+	if len(tb.errors) != 0 || result != 42 {
+		t.Errorf("Expected (42, no failures), got (%d, %q)", result, tb.errors)
+	}
+
+	reached := false
+	tb = run(func(tb *fakeTB) {
+		MustT[int](tb)(0, errors.New("setup failed"))
+		reached = true
+	})
+	if !tb.fatal {
+		t.Error("Expected Fatalf to be called")
+	}
+	if reached {
+		t.Error("Expected test to stop after Fatalf")
+	}
+	if len(tb.errors) != 1 || !strings.Contains(tb.errors[0], "setup failed") {
+		t.Errorf("Expected failure mentioning the error, got %q", tb.errors)
+	}
+}
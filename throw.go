@@ -0,0 +1,107 @@
+package sugar
+
+import (
+	"errors"
+	"reflect"
+)
+
+// Throw panics with err. It is the explicit counterpart of CatchAs and TryAs
+// for code that uses panics for control flow internally, such as a recursive
+// descent parser bailing out of deeply nested calls. err must be non-nil.
+//
+// Example usage:
+//
+//	type SyntaxError struct {
+//	    Pos int
+//	    Msg string
+//	}
+//	func (e *SyntaxError) Error() string { ... }
+//
+//	func (p *parser) expect(tok token) {
+//	    if p.tok != tok {
+//	        Throw(&SyntaxError{Pos: p.pos, Msg: "expected " + tok.String()})
+//	    }
+//	    p.next()
+//	}
+func Throw(err error) {
+	panic(err)
+}
+
+// CatchAs runs f and recovers a panic only if it matches type E: either the
+// panic value has type E, or it is an error whose chain contains an E
+// according to errors.As. A matching panic is returned with ok set to true.
+//
+// Every other panic is re-raised from within the recovering deferred call,
+// before the stack unwinds, so a crash still reports the stack of the
+// original panic. This lets panic-based control flow coexist with genuine
+// bugs such as an index out of range, which Try would silently turn into an
+// error. Note that runtime errors implement error, so E should be a specific
+// type rather than error itself.
+//
+// Example usage:
+//
+//	synErr, ok := CatchAs[*SyntaxError](func() {
+//	    p.parseFile()
+//	})
+//	if ok {
+//	    return fmt.Errorf("line %d: %w", synErr.Pos, synErr)
+//	}
+func CatchAs[E any](f func()) (caught E, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if caught, ok = matchPanic[E](r); !ok {
+				panic(r)
+			}
+		}
+	}()
+	f()
+	return caught, false
+}
+
+// TryAs is like CatchAs for functions that return a value. If f returns
+// normally, TryAs returns its result, Zero[E]() and false. If f panics with a
+// value matching E, TryAs returns Zero[T](), the caught value and true. Every
+// other panic is re-raised as in CatchAs.
+//
+// Example usage:
+//
+//	ast, synErr, failed := TryAs[*Node, *SyntaxError](p.parseFile)
+//	if failed {
+//	    return nil, synErr
+//	}
+func TryAs[T, E any](f func() T) (retval T, caught E, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if caught, ok = matchPanic[E](r); !ok {
+				panic(r)
+			}
+			retval = Zero[T]()
+		}
+	}()
+	return f(), caught, false
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// matchPanic reports whether the recovered value r matches type E, either
+// directly or through the chain of an error, and returns the match.
+func matchPanic[E any](r any) (E, bool) {
+	if e, ok := r.(E); ok {
+		return e, true
+	}
+
+	err, ok := r.(error)
+	if !ok {
+		return Zero[E](), false
+	}
+	// errors.As panics unless the target is an interface or an error type.
+	t := reflect.TypeOf((*E)(nil)).Elem()
+	if t.Kind() != reflect.Interface && !t.Implements(errorType) {
+		return Zero[E](), false
+	}
+	var e E
+	if errors.As(err, &e) {
+		return e, true
+	}
+	return Zero[E](), false
+}
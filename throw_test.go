@@ -0,0 +1,203 @@
+package sugar
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+type throwSyntaxError struct {
+	Pos int
+}
+
+func (e *throwSyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at %d", e.Pos)
+}
+
+func TestThrow(t *testing.T) {
+	// Test that Throw panics with the error unchanged
+	testErr := errors.New("test error")
+
+	defer func() {
+		if r := recover(); r != testErr {
+			t.Errorf("Expected panic with %v, got %v", testErr, r)
+		}
+	}()
+
+	Throw(testErr)
+	t.Error("Expected function to panic, but it didn't")
+}
+
+func TestCatchAs_NoPanic(t *testing.T) {
+	// Test that nothing is caught when f returns normally
+	called := false
+	caught, ok := CatchAs[*throwSyntaxError](func() { called = true })
+
+	if ok || caught != nil {
+		t.Errorf("Expected nothing caught, got (%v, %t)", caught, ok)
+	}
+	if !called {
+		t.Error("Expected function to be called")
+	}
+}
+
+func TestCatchAs_Matching(t *testing.T) {
+	// Test that matching panics are caught directly and through wrapping
+	t.Run("direct", func(t *testing.T) {
+		caught, ok := CatchAs[*throwSyntaxError](func() {
+			Throw(&throwSyntaxError{Pos: 3})
+		})
+
+		if !ok || caught == nil || caught.Pos != 3 {
+			t.Errorf("Expected *throwSyntaxError at 3, got (%v, %t)", caught, ok)
+		}
+	})
+
+	t.Run("wrapped", func(t *testing.T) {
+		caught, ok := CatchAs[*throwSyntaxError](func() {
+			Throw(fmt.Errorf("parsing: %w", &throwSyntaxError{Pos: 7}))
+		})
+
+		if !ok || caught == nil || caught.Pos != 7 {
+			t.Errorf("Expected *throwSyntaxError at 7, got (%v, %t)", caught, ok)
+		}
+	})
+
+	t.Run("non_error_value", func(t *testing.T) {
+		caught, ok := CatchAs[string](func() {
+			panic("bail out")
+		})
+
+		if !ok || caught != "bail out" {
+			t.Errorf("Expected (bail out, true), got (%q, %t)", caught, ok)
+		}
+	})
+
+	t.Run("interface", func(t *testing.T) {
+		caught, ok := CatchAs[runtime.Error](func() {
+			var m map[string]int
+			m["key"] = 1
+		})
+
+		if !ok || caught == nil {
+			t.Errorf("Expected runtime.Error to be caught, got (%v, %t)", caught, ok)
+		}
+	})
+}
+
+func TestCatchAs_Repanics(t *testing.T) {
+	// Test that non-matching panics are re-raised unchanged
+	t.Run("runtime_error", func(t *testing.T) {
+		defer func() {
+			if _, ok := recover().(runtime.Error); !ok {
+				t.Error("Expected runtime error to propagate")
+			}
+		}()
+
+		CatchAs[*throwSyntaxError](func() {
+			arr := []int{1, 2, 3}
+			i := 10
+			_ = arr[i]
+		})
+		t.Error("Expected function to panic, but it didn't")
+	})
+
+	t.Run("other_error", func(t *testing.T) {
+		testErr := errors.New("test error")
+		defer func() {
+			if r := recover(); r != testErr {
+				t.Errorf("Expected panic with %v, got %v", testErr, r)
+			}
+		}()
+
+		CatchAs[*throwSyntaxError](func() { Throw(testErr) })
+		t.Error("Expected function to panic, but it didn't")
+	})
+
+	t.Run("non_error_type_with_error_panic", func(t *testing.T) {
+		// E is neither an interface nor an error, so errors.As must not be used
+		defer func() {
+			if r := recover(); r != os.ErrClosed {
+				t.Errorf("Expected panic with %v, got %v", os.ErrClosed, r)
+			}
+		}()
+
+		CatchAs[string](func() { Throw(os.ErrClosed) })
+		t.Error("Expected function to panic, but it didn't")
+	})
+}
+
+func TestCatchAs_PreservesStack(t *testing.T) {
+	// Test that a re-raised panic still reports the original panicking frame
+	if os.Getenv("SUGAR_TEST_CRASH") == "1" {
+		CatchAs[*throwSyntaxError](func() {
+			throwCrashingHelper()
+		})
+		return
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=^TestCatchAs_PreservesStack$")
+	cmd.Env = append(os.Environ(), "SUGAR_TEST_CRASH=1")
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		t.Fatal("Expected subprocess to crash")
+	}
+	if !strings.Contains(string(out), "throwCrashingHelper") {
+		t.Errorf("Expected crash output to contain original frame, got:\n%s", out)
+	}
+}
+
+func throwCrashingHelper() {
+	panic("original panic")
+}
+
+func TestTryAs(t *testing.T) {
+	// Test TryAs with normal returns, matching panics and other panics
+	t.Run("no_panic", func(t *testing.T) {
+		v, caught, ok := TryAs[int, *throwSyntaxError](func() int { return 42 })
+
+		if v != 42 || caught != nil || ok {
+			t.Errorf("Expected (42, nil, false), got (%d, %v, %t)", v, caught, ok)
+		}
+	})
+
+	t.Run("matching_panic", func(t *testing.T) {
+		v, caught, ok := TryAs[string, *throwSyntaxError](func() string {
+			Throw(&throwSyntaxError{Pos: 5})
+			return "unreachable"
+		})
+
+		if v != "" {
+			t.Errorf("Expected zero value, got %q", v)
+		}
+		if !ok || caught.Pos != 5 {
+			t.Errorf("Expected *throwSyntaxError at 5, got (%v, %t)", caught, ok)
+		}
+	})
+
+	t.Run("other_panic", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("Expected panic with boom, got %v", r)
+			}
+		}()
+
+		TryAs[int, *throwSyntaxError](func() int { panic("boom") })
+		t.Error("Expected function to panic, but it didn't")
+	})
+}
+
+// Benchmark tests
+func BenchmarkCatchAs_Matching(b *testing.B) {
+	err := &throwSyntaxError{Pos: 1}
+	f := func() { Throw(err) }
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		CatchAs[*throwSyntaxError](f)
+	}
+}
@@ -1,7 +1,5 @@
 package sugar
 
-import "fmt"
-
 // Try is a generic utility function that executes a function and converts any
 // panics that occur during execution into regular Go errors. This provides a
 // safe way to call potentially panicking code by transforming panic-based
@@ -12,8 +10,10 @@ import "fmt"
 //   - If f() panics, recovers from the panic and returns (zero_value, error)
 //
 // When a panic is recovered, the returned value will be the zero value for
-// type T (obtained via Zero[T]()), and the error will contain the panic value
-// formatted as "panic: <value>".
+// type T (obtained via Zero[T]()), and the error will be a *PanicError holding
+// the recovered value and the stack of the panicking goroutine. Its message is
+// the panic value formatted as "panic: <value>", and if the panic value is an
+// error, errors.Is and errors.As see through to it.
 //
 // Type parameter T can be any type, making this function work with any
 // function that returns a single value of type T.
@@ -24,7 +24,7 @@ import "fmt"
 //
 // Returns:
 //   - retval: The return value of f() on success, or Zero[T]() if f() panics
-//   - err: nil on success, or an error describing the panic if f() panics
+//   - err: nil on success, or a *PanicError describing the panic if f() panics
 //
 // Example usage:
 //
@@ -47,6 +47,7 @@ import "fmt"
 //	    log.Printf("Type assertion failed: %v", err)
 //	    // result is 0 (zero value for int)
 //	}
+//	// Cast[int](val) does the same without the cost of a panic
 //
 //	// Safe nil pointer dereference protection
 //	var ptr *string = nil
@@ -144,8 +145,85 @@ func Try[T any](f func() T) (retval T, err error) {
 	defer func() {
 		if r := recover(); r != nil {
 			retval = Zero[T]()
-			err = fmt.Errorf("panic: %v", r)
+			err = newPanicError(r)
 		}
 	}()
 	return f(), nil
 }
+
+// Try0 is the zero-value variant of Try for functions that return nothing. It
+// runs f and returns a *PanicError if f panics, or nil otherwise.
+//
+// Example usage:
+//
+//	if err := Try0(func() { plugin.Shutdown() }); err != nil {
+//	    log.Printf("plugin shutdown panicked: %v", err)
+//	}
+func Try0(f func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = newPanicError(r)
+		}
+	}()
+	f()
+	return nil
+}
+
+// Try2 is the two-value variant of Try. If f panics, both results are set to
+// their zero values and the panic is returned as a *PanicError.
+//
+// Example usage:
+//
+//	host, port, err := Try2(func() (string, string) {
+//	    return Must2(net.SplitHostPort(addr))
+//	})
+func Try2[T1, T2 any](f func() (T1, T2)) (r1 T1, r2 T2, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			r1, r2 = Zero[T1](), Zero[T2]()
+			err = newPanicError(r)
+		}
+	}()
+	r1, r2 = f()
+	return r1, r2, nil
+}
+
+// Try3 is the three-value variant of Try. If f panics, all results are set to
+// their zero values and the panic is returned as a *PanicError.
+func Try3[T1, T2, T3 any](f func() (T1, T2, T3)) (r1 T1, r2 T2, r3 T3, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			r1, r2, r3 = Zero[T1](), Zero[T2](), Zero[T3]()
+			err = newPanicError(r)
+		}
+	}()
+	r1, r2, r3 = f()
+	return r1, r2, r3, nil
+}
+
+// TryE is like Try for functions that already follow the (value, error)
+// convention but may also panic. It merges both failure modes into a single
+// error result:
+//   - If f returns normally, its value and error are returned unchanged
+//   - If f panics, TryE returns Zero[T]() and a *PanicError
+//
+// A panic can still be told apart from an ordinary error with errors.As:
+//
+//	cfg, err := TryE(func() (Config, error) {
+//	    var cfg Config
+//	    err := thirdparty.Decode(data, &cfg)
+//	    return cfg, err
+//	})
+//	var pe *PanicError
+//	if errors.As(err, &pe) {
+//	    log.Printf("decoder panicked:\n%s", pe.Stack)
+//	}
+func TryE[T any](f func() (T, error)) (retval T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			retval = Zero[T]()
+			err = newPanicError(r)
+		}
+	}()
+	return f()
+}
@@ -432,6 +432,123 @@ func TestTry_FunctionTypes(t *testing.T) {
 	})
 }
 
+func TestTry_Arities(t *testing.T) {
+	// Test the multi-value Try variants
+	t.Run("try0_no_panic", func(t *testing.T) {
+		called := false
+		err := Try0(func() { called = true })
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if !called {
+			t.Error("Expected function to be called")
+		}
+	})
+
+	t.Run("try0_with_panic", func(t *testing.T) {
+		err := Try0(func() { panic("boom") })
+
+		var pe *PanicError
+		if !errors.As(err, &pe) || pe.Value != "boom" {
+			t.Errorf("Expected *PanicError with value boom, got %v", err)
+		}
+	})
+
+	t.Run("try2_no_panic", func(t *testing.T) {
+		a, b, err := Try2(func() (int, string) { return 1, "one" })
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if a != 1 || b != "one" {
+			t.Errorf("Expected (1, one), got (%d, %s)", a, b)
+		}
+	})
+
+	t.Run("try2_with_panic", func(t *testing.T) {
+		a, b, err := Try2(func() (*int, []string) {
+			return Ptr(1), Must([]string{"x"}, errors.New("failed"))
+		})
+
+		if err == nil {
+			t.Error("Expected error, got nil")
+		}
+		if a != nil || b != nil {
+			t.Errorf("Expected zero values, got (%v, %v)", a, b)
+		}
+	})
+
+	t.Run("try3_no_panic", func(t *testing.T) {
+		a, b, c, err := Try3(func() (int, string, bool) { return 1, "one", true })
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if a != 1 || b != "one" || c != true {
+			t.Errorf("Expected (1, one, true), got (%d, %s, %t)", a, b, c)
+		}
+	})
+
+	t.Run("try3_with_panic", func(t *testing.T) {
+		a, b, c, err := Try3(func() (int, string, bool) { panic("boom") })
+
+		if err == nil {
+			t.Error("Expected error, got nil")
+		}
+		if a != 0 || b != "" || c != false {
+			t.Errorf("Expected zero values, got (%d, %q, %t)", a, b, c)
+		}
+	})
+}
+
+func TestTryE(t *testing.T) {
+	// Test that returned errors and recovered panics are merged into one error
+	t.Run("success", func(t *testing.T) {
+		result, err := TryE(func() (int, error) { return 42, nil })
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if result != 42 {
+			t.Errorf("Expected 42, got %d", result)
+		}
+	})
+
+	t.Run("returned_error", func(t *testing.T) {
+		testErr := errors.New("test error")
+		result, err := TryE(func() (string, error) { return "partial", testErr })
+
+		if err != testErr {
+			t.Errorf("Expected %v, got %v", testErr, err)
+		}
+		if result != "partial" {
+			t.Errorf("Expected returned value %q, got %q", "partial", result)
+		}
+
+		var pe *PanicError
+		if errors.As(err, &pe) {
+			t.Error("Expected returned error not to be a *PanicError")
+		}
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		result, err := TryE(func() (string, error) { panic("boom") })
+
+		if result != "" {
+			t.Errorf("Expected zero value, got %q", result)
+		}
+
+		var pe *PanicError
+		if !errors.As(err, &pe) {
+			t.Fatalf("Expected *PanicError, got %v", err)
+		}
+		if pe.Value != "boom" {
+			t.Errorf("Expected panic value boom, got %v", pe.Value)
+		}
+	})
+}
+
 // Benchmark tests
 func BenchmarkTry_NoError(b *testing.B) {
 	f := func() int { return 42 }
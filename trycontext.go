@@ -0,0 +1,58 @@
+package sugar
+
+import (
+	"context"
+	"fmt"
+)
+
+// TryContext runs f under Try on a separate goroutine and waits for it to
+// finish or for ctx to be done, whichever happens first. This bounds how long
+// a caller waits on code it does not control, such as plugins or third-party
+// libraries.
+//
+// The function returns:
+//   - (result, nil) if f finishes normally
+//   - (Zero[T](), *PanicError) if f panics, exactly as Try would
+//   - (Zero[T](), err) if ctx is done first, where err wraps ctx.Err() so that
+//     errors.Is(err, context.DeadlineExceeded) and
+//     errors.Is(err, context.Canceled) work
+//
+// If ctx is already done, f is not started. Otherwise f receives ctx and
+// should return promptly once it is cancelled; Go cannot stop a goroutine from
+// the outside, so an f that ignores ctx keeps running in the background after
+// TryContext has returned, and its result is discarded.
+//
+// Example usage:
+//
+//	ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
+//	defer cancel()
+//	out, err := TryContext(ctx, func(ctx context.Context) Output {
+//	    return plugin.Run(ctx, input)
+//	})
+//	if errors.Is(err, context.DeadlineExceeded) {
+//	    // plugin took too long
+//	}
+func TryContext[T any](ctx context.Context, f func(context.Context) T) (T, error) {
+	if err := ctx.Err(); err != nil {
+		return Zero[T](), fmt.Errorf("sugar: context done before start: %w", err)
+	}
+
+	type result struct {
+		v   T
+		err error
+	}
+	// Buffered so the goroutine can always deliver its result and exit, even
+	// when nobody is waiting for it any more.
+	done := make(chan result, 1)
+	go func() {
+		v, err := Try(func() T { return f(ctx) })
+		done <- result{v, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.v, r.err
+	case <-ctx.Done():
+		return Zero[T](), fmt.Errorf("sugar: context done before function returned: %w", ctx.Err())
+	}
+}
@@ -0,0 +1,114 @@
+package sugar
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTryContext_NoError(t *testing.T) {
+	// Test that the result is returned when f finishes before the context is done
+	result, err := TryContext(context.Background(), func(ctx context.Context) int {
+		return 42
+	})
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if result != 42 {
+		t.Errorf("Expected 42, got %d", result)
+	}
+}
+
+func TestTryContext_WithPanic(t *testing.T) {
+	// Test that panics in f are converted to *PanicError
+	result, err := TryContext(context.Background(), func(ctx context.Context) string {
+		panic("boom")
+	})
+
+	if result != "" {
+		t.Errorf("Expected zero value, got %q", result)
+	}
+
+	var pe *PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("Expected *PanicError, got %v", err)
+	}
+	if pe.Value != "boom" {
+		t.Errorf("Expected panic value boom, got %v", pe.Value)
+	}
+}
+
+func TestTryContext_Timeout(t *testing.T) {
+	// Test that a deadline stops the wait and wraps context.DeadlineExceeded
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	release := make(chan struct{})
+	defer close(release)
+
+	result, err := TryContext(ctx, func(ctx context.Context) int {
+		<-release
+		return 42
+	})
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if result != 0 {
+		t.Errorf("Expected zero value, got %d", result)
+	}
+}
+
+func TestTryContext_Cancel(t *testing.T) {
+	// Test that cancellation stops the wait and wraps context.Canceled
+	ctx, cancel := context.WithCancel(context.Background())
+
+	started := make(chan struct{})
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	_, err := TryContext(ctx, func(ctx context.Context) int {
+		close(started)
+		<-ctx.Done()
+		time.Sleep(10 * time.Millisecond)
+		return 42
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+func TestTryContext_AlreadyDone(t *testing.T) {
+	// Test that f is not started when the context is already done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	called := false
+	_, err := TryContext(ctx, func(ctx context.Context) int {
+		called = true
+		return 42
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if called {
+		t.Error("Expected function not to be called")
+	}
+}
+
+// Benchmark tests
+func BenchmarkTryContext_NoError(b *testing.B) {
+	ctx := context.Background()
+	f := func(ctx context.Context) int { return 42 }
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		TryContext(ctx, f)
+	}
+}
@@ -1,6 +1,150 @@
 package sugar
 
+import "reflect"
+
 func Zero[T any]() T {
 	var v T
 	return v
 }
+
+// IsZero reports whether v is the zero value for T, as returned by Zero[T]().
+//
+// Example usage:
+//
+//	if IsZero(cfg.Host) {
+//	    cfg.Host = "localhost"
+//	}
+func IsZero[T comparable](v T) bool {
+	return v == Zero[T]()
+}
+
+// ZeroOption configures IsZeroDeep and ZeroFields.
+type ZeroOption func(*zeroConfig)
+
+type zeroConfig struct {
+	emptyAsZero bool
+}
+
+// EmptyAsZero makes IsZeroDeep and ZeroFields treat empty but non-nil slices
+// and maps as zero, in addition to nil ones.
+func EmptyAsZero() ZeroOption {
+	return func(c *zeroConfig) {
+		c.emptyAsZero = true
+	}
+}
+
+// IsZeroDeep reports whether v holds a zero value, and works for any type,
+// including non-comparable ones such as slices, maps and structs containing
+// them. Structs and arrays are zero when all of their elements are, and an
+// interface is zero when it is nil or holds a zero value.
+//
+// A non-nil pointer is never zero, even if it points to a zero value, because
+// a pointer is commonly how an optional field records that it was set. Empty
+// but non-nil slices and maps are not zero unless EmptyAsZero is given.
+//
+// Example usage:
+//
+//	IsZeroDeep(Config{})                                // true
+//	IsZeroDeep(Config{Tags: []string{}})                // false
+//	IsZeroDeep(Config{Tags: []string{}}, EmptyAsZero()) // true
+func IsZeroDeep(v any, opts ...ZeroOption) bool {
+	var c zeroConfig
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return isZeroDeep(reflect.ValueOf(v), &c)
+}
+
+func isZeroDeep(v reflect.Value, c *zeroConfig) bool {
+	if !v.IsValid() {
+		return true
+	}
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.IsNil() || (c.emptyAsZero && v.Len() == 0)
+	case reflect.Interface:
+		return v.IsNil() || isZeroDeep(v.Elem(), c)
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if !isZeroDeep(v.Index(i), c) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if !isZeroDeep(v.Field(i), c) {
+				return false
+			}
+		}
+		return true
+	default:
+		return v.IsZero()
+	}
+}
+
+// ZeroFields returns the dotted paths of the exported fields of struct v that
+// are still zero according to IsZeroDeep. v may be a struct or a pointer to
+// one; any other value yields nil. A nil struct pointer is missing as a whole
+// and yields the struct's type name, such as ["Config"].
+//
+// Nested structs and non-nil pointers to structs are walked, so that their
+// zero fields are reported individually (for example "Database.Host"). A nil
+// struct pointer is reported as a whole. Structs with unexported fields, such
+// as time.Time, are treated as a single value.
+//
+// This is useful to validate required configuration before startup:
+//
+//	if missing := ZeroFields(cfg); len(missing) > 0 {
+//	    log.Fatalf("missing required config: %s", strings.Join(missing, ", "))
+//	}
+func ZeroFields(v any, opts ...ZeroOption) []string {
+	var c zeroConfig
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		if rv.Type().Elem().Kind() == reflect.Struct && rv.IsNil() {
+			return []string{typeName(rv.Type().Elem())}
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+	var paths []string
+	zeroFields(rv, "", &c, &paths)
+	return paths
+}
+
+func zeroFields(v reflect.Value, prefix string, c *zeroConfig, paths *[]string) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		f := v.Field(i)
+		path := prefix + field.Name
+
+		switch {
+		case f.Kind() == reflect.Struct && allFieldsExported(f.Type()):
+			zeroFields(f, path+".", c, paths)
+		case f.Kind() == reflect.Pointer && !f.IsNil() &&
+			f.Elem().Kind() == reflect.Struct && allFieldsExported(f.Elem().Type()):
+			zeroFields(f.Elem(), path+".", c, paths)
+		case isZeroDeep(f, c):
+			*paths = append(*paths, path)
+		}
+	}
+}
+
+// typeName returns the name of t, or its literal form if t is unnamed.
+func typeName(t reflect.Type) string {
+	if name := t.Name(); name != "" {
+		return name
+	}
+	return t.String()
+}
@@ -1,7 +1,9 @@
 package sugar
 
 import (
+	"reflect"
 	"testing"
+	"time"
 	"unsafe"
 )
 
@@ -534,6 +536,143 @@ func TestZero_UsagePatterns(t *testing.T) {
 	})
 }
 
+func TestIsZero(t *testing.T) {
+	// Test IsZero with comparable types
+	t.Run("int", func(t *testing.T) {
+		if !IsZero(0) || IsZero(42) {
+			t.Error("Expected IsZero(0) to be true and IsZero(42) to be false")
+		}
+	})
+
+	t.Run("string", func(t *testing.T) {
+		if !IsZero("") || IsZero("hello") {
+			t.Error("Expected IsZero(\"\") to be true and IsZero(\"hello\") to be false")
+		}
+	})
+
+	t.Run("pointer", func(t *testing.T) {
+		if !IsZero[*int](nil) || IsZero(Ptr(0)) {
+			t.Error("Expected nil pointer to be zero and non-nil pointer not to be")
+		}
+	})
+
+	t.Run("struct", func(t *testing.T) {
+		type TestStruct struct {
+			Name string
+			Age  int
+		}
+		if !IsZero(TestStruct{}) || IsZero(TestStruct{Age: 1}) {
+			t.Error("Expected only the empty struct to be zero")
+		}
+	})
+}
+
+func TestIsZeroDeep(t *testing.T) {
+	// Test IsZeroDeep with non-comparable and nested types
+	type Inner struct {
+		Values []int
+	}
+	type Outer struct {
+		Name   string
+		Inner  Inner
+		Labels map[string]string
+		Any    any
+		Ptr    *int
+	}
+
+	tests := []struct {
+		name     string
+		value    any
+		expected bool
+		opts     []ZeroOption
+	}{
+		{"nil", nil, true, nil},
+		{"zero_struct", Outer{}, true, nil},
+		{"nested_slice_set", Outer{Inner: Inner{Values: []int{1}}}, false, nil},
+		{"empty_slice", Outer{Inner: Inner{Values: []int{}}}, false, nil},
+		{"empty_slice_as_zero", Outer{Inner: Inner{Values: []int{}}}, true, []ZeroOption{EmptyAsZero()}},
+		{"empty_map_as_zero", Outer{Labels: map[string]string{}}, true, []ZeroOption{EmptyAsZero()}},
+		{"interface_holding_zero", Outer{Any: 0}, true, nil},
+		{"interface_holding_value", Outer{Any: 1}, false, nil},
+		{"pointer_to_zero", Outer{Ptr: Ptr(0)}, false, nil},
+		{"array", [2][]int{}, true, nil},
+		{"array_set", [2][]int{nil, {1}}, false, nil},
+		{"func", (func())(nil), true, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := IsZeroDeep(tt.value, tt.opts...); result != tt.expected {
+				t.Errorf("Expected IsZeroDeep(%+v) to be %t, got %t", tt.value, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestZeroFields(t *testing.T) {
+	// Test that zero fields are reported by dotted path
+	type Database struct {
+		Host string
+		Port int
+	}
+	type Config struct {
+		Name     string
+		Tags     []string
+		Database Database
+		Cache    *Database
+		Backup   *Database
+		Started  time.Time
+		internal string
+	}
+
+	cfg := Config{
+		Tags:     []string{},
+		Database: Database{Host: "db"},
+		Backup:   &Database{Port: 5432},
+	}
+
+	t.Run("default", func(t *testing.T) {
+		expected := []string{"Name", "Database.Port", "Cache", "Backup.Host", "Started"}
+		result := ZeroFields(cfg)
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %q, got %q", expected, result)
+		}
+	})
+
+	t.Run("empty_as_zero", func(t *testing.T) {
+		expected := []string{"Name", "Tags", "Database.Port", "Cache", "Backup.Host", "Started"}
+		result := ZeroFields(&cfg, EmptyAsZero())
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Expected %q, got %q", expected, result)
+		}
+	})
+
+	t.Run("no_zero_fields", func(t *testing.T) {
+		full := Database{Host: "db", Port: 5432}
+		if result := ZeroFields(full); len(result) != 0 {
+			t.Errorf("Expected no zero fields, got %q", result)
+		}
+	})
+
+	t.Run("nil_struct_pointer", func(t *testing.T) {
+		var db *Database
+		if result := ZeroFields(db); !reflect.DeepEqual(result, []string{"Database"}) {
+			t.Errorf("Expected nil root reported as [Database], got %q", result)
+		}
+
+		var anon *struct{ Host string }
+		if result := ZeroFields(anon); !reflect.DeepEqual(result, []string{"struct { Host string }"}) {
+			t.Errorf("Expected unnamed nil root reported by its literal type, got %q", result)
+		}
+	})
+
+	t.Run("non_struct", func(t *testing.T) {
+		if result := ZeroFields(42); result != nil {
+			t.Errorf("Expected nil for non-struct, got %q", result)
+		}
+	})
+}
+
 // Benchmark tests
 func BenchmarkZero_Int(b *testing.B) {
 	b.ResetTimer()